@@ -0,0 +1,76 @@
+// Package adf converts between Markdown (or plain text) and the Atlassian Document Format
+// used by rich text fields of the JIRA Cloud REST API v3.
+//
+// Only the subset of Markdown that maps cleanly onto ADF is supported: paragraphs, headings,
+// bullet and ordered lists, fenced code blocks, block quotes, horizontal rules and the inline
+// marks strong, em, strike, code and link. Everything else is kept as literal text, so plain
+// text passes through unchanged. Line breaks inside a paragraph become hard breaks.
+package adf
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Node is a single ADF node, as encoded in JSON.
+type Node = map[string]interface{}
+
+// FromMarkdown converts Markdown or plain text into an ADF document. Empty or blank input
+// returns nil, which encodes as JSON null, because JIRA expects no document rather than a
+// document without content.
+func FromMarkdown(markdown string) Node {
+	blocks := parseBlocks(splitLines(markdown))
+	if len(blocks) == 0 {
+		return nil
+	}
+	return Node{
+		"type":    "doc",
+		"version": 1,
+		"content": blocks,
+	}
+}
+
+// ToMarkdown converts an ADF document, as decoded from JSON, into Markdown.
+// Nodes without a Markdown equivalent are rendered as their text content.
+func ToMarkdown(doc interface{}) string {
+	root, ok := doc.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	return renderBlocks(children(root))
+}
+
+// Equal reports whether two ADF documents are the same once encoded as JSON,
+// regardless of the Go types used to build them.
+func Equal(a, b interface{}) bool {
+	na, err := normalize(a)
+	if err != nil {
+		return false
+	}
+	nb, err := normalize(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(na, nb)
+}
+
+func normalize(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = json.Unmarshal(data, &out)
+	return out, err
+}
+
+func children(node map[string]interface{}) []map[string]interface{} {
+	raw, _ := node["content"].([]interface{})
+	var result []map[string]interface{}
+	for _, item := range raw {
+		if child, ok := item.(map[string]interface{}); ok {
+			result = append(result, child)
+		}
+	}
+	return result
+}
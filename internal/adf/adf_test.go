@@ -0,0 +1,126 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFromMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "empty",
+			markdown: "",
+			want:     `null`,
+		},
+		{
+			name:     "blank",
+			markdown: "\n  \n",
+			want:     `null`,
+		},
+		{
+			name:     "heading",
+			markdown: "## Getting *started*",
+			want: `{"type":"doc","version":1,"content":[
+				{"type":"heading","attrs":{"level":2},"content":[
+					{"type":"text","text":"Getting "},
+					{"type":"text","text":"started","marks":[{"type":"em"}]}
+				]}
+			]}`,
+		},
+		{
+			name:     "nested marks",
+			markdown: "**bold *both***",
+			want: `{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[
+					{"type":"text","text":"bold ","marks":[{"type":"strong"}]},
+					{"type":"text","text":"both","marks":[{"type":"strong"},{"type":"em"}]}
+				]}
+			]}`,
+		},
+		{
+			name:     "code drops other marks",
+			markdown: "**run `make` first**",
+			want: `{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[
+					{"type":"text","text":"run ","marks":[{"type":"strong"}]},
+					{"type":"text","text":"make","marks":[{"type":"code"}]},
+					{"type":"text","text":" first","marks":[{"type":"strong"}]}
+				]}
+			]}`,
+		},
+		{
+			name:     "code keeps link",
+			markdown: "[`jira`](https://example.com)",
+			want: `{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[
+					{"type":"text","text":"jira","marks":[{"type":"link","attrs":{"href":"https://example.com"}},{"type":"code"}]}
+				]}
+			]}`,
+		},
+		{
+			name:     "code block",
+			markdown: "```hcl\nname = \"x\"\n```",
+			want: `{"type":"doc","version":1,"content":[
+				{"type":"codeBlock","attrs":{"language":"hcl"},"content":[{"type":"text","text":"name = \"x\""}]}
+			]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want interface{}
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			got := FromMarkdown(tt.markdown)
+			if !Equal(got, want) {
+				data, _ := json.Marshal(got)
+				t.Errorf("FromMarkdown(%q) = %s", tt.markdown, data)
+			}
+		})
+	}
+}
+
+// Markdown in the form ToMarkdown writes survives a round trip through ADF, as JIRA
+// returns it.
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+	}{
+		{"empty", ""},
+		{"paragraphs", "First line\nsecond line\n\nNext paragraph"},
+		{"headings", "# Title\n\n### Section with **bold**"},
+		{"bullet list", "- one\n- two\n- three"},
+		{"ordered list", "3. three\n4. four"},
+		{"nested lists", "- parent\n  1. first\n  2. second\n- sibling"},
+		{"nested marks", "**bold** ***both*** and ~~*struck*~~"},
+		{"strong and em", "***both***"},
+		{"inline code", "Run `terraform apply` now"},
+		{"code block", "```go\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n```"},
+		{"code block without language", "```\nplain\n```"},
+		{"links", "See [the docs](https://example.com/docs) or [**bold link**](https://example.com)"},
+		{"block quote", "> quoted\n> text"},
+		{"rule", "above\n\n---\n\nbelow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(FromMarkdown(tt.markdown))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var doc interface{}
+			if err := json.Unmarshal(data, &doc); err != nil {
+				t.Fatal(err)
+			}
+			if got := ToMarkdown(doc); got != tt.markdown {
+				t.Errorf("round trip of %q = %q", tt.markdown, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package adf
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	headingPattern  = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	rulePattern     = regexp.MustCompile(`^(?:-{3,}|\*{3,}|_{3,})\s*$`)
+	bulletPattern   = regexp.MustCompile(`^[-*+]\s+(.*)$`)
+	orderedPattern  = regexp.MustCompile(`^(\d+)[.)]\s+(.*)$`)
+	fencePattern    = regexp.MustCompile("^(```+|~~~+)\\s*(\\S*)\\s*$")
+	quotePattern    = regexp.MustCompile(`^>\s?(.*)$`)
+	listIndentWidth = 2
+)
+
+func splitLines(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.Split(text, "\n")
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// startsBlock reports whether line opens a block other than a paragraph.
+func startsBlock(line string) bool {
+	return headingPattern.MatchString(line) || rulePattern.MatchString(line) ||
+		bulletPattern.MatchString(line) || orderedPattern.MatchString(line) ||
+		fencePattern.MatchString(line) || quotePattern.MatchString(line)
+}
+
+func parseBlocks(lines []string) []interface{} {
+	blocks := []interface{}{}
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch {
+		case isBlank(line):
+			i++
+
+		case fencePattern.MatchString(line):
+			m := fencePattern.FindStringSubmatch(line)
+			var code []string
+			i++
+			for i < len(lines) && strings.TrimSpace(lines[i]) != m[1] {
+				code = append(code, lines[i])
+				i++
+			}
+			i++ // closing fence
+			block := Node{"type": "codeBlock"}
+			if m[2] != "" {
+				block["attrs"] = Node{"language": m[2]}
+			}
+			if text := strings.Join(code, "\n"); text != "" {
+				block["content"] = []interface{}{Node{"type": "text", "text": text}}
+			}
+			blocks = append(blocks, block)
+
+		case headingPattern.MatchString(line):
+			m := headingPattern.FindStringSubmatch(line)
+			block := Node{
+				"type":  "heading",
+				"attrs": Node{"level": len(m[1])},
+			}
+			if inline := parseInline(m[2]); len(inline) > 0 {
+				block["content"] = inline
+			}
+			blocks = append(blocks, block)
+			i++
+
+		case rulePattern.MatchString(line):
+			blocks = append(blocks, Node{"type": "rule"})
+			i++
+
+		case quotePattern.MatchString(line):
+			var quoted []string
+			for i < len(lines) && quotePattern.MatchString(lines[i]) {
+				quoted = append(quoted, quotePattern.FindStringSubmatch(lines[i])[1])
+				i++
+			}
+			blocks = append(blocks, Node{
+				"type":    "blockquote",
+				"content": parseBlocks(quoted),
+			})
+
+		case bulletPattern.MatchString(line), orderedPattern.MatchString(line):
+			var list Node
+			list, i = parseList(lines, i)
+			blocks = append(blocks, list)
+
+		default:
+			var text []string
+			for i < len(lines) && !isBlank(lines[i]) && (len(text) == 0 || !startsBlock(lines[i])) {
+				text = append(text, lines[i])
+				i++
+			}
+			blocks = append(blocks, paragraph(text))
+		}
+	}
+	return blocks
+}
+
+// paragraph builds a paragraph, turning line breaks into hard breaks.
+func paragraph(lines []string) Node {
+	var content []interface{}
+	for n, line := range lines {
+		if n > 0 {
+			content = append(content, Node{"type": "hardBreak"})
+		}
+		content = append(content, parseInline(line)...)
+	}
+	block := Node{"type": "paragraph"}
+	if len(content) > 0 {
+		block["content"] = content
+	}
+	return block
+}
+
+// parseList parses consecutive items of one list starting at lines[start].
+// Lines indented under an item belong to it and may hold nested lists.
+func parseList(lines []string, start int) (Node, int) {
+	ordered := orderedPattern.MatchString(lines[start])
+	list := Node{"type": "bulletList"}
+	if ordered {
+		list["type"] = "orderedList"
+		if order, err := strconv.Atoi(orderedPattern.FindStringSubmatch(lines[start])[1]); err == nil && order != 1 {
+			list["attrs"] = Node{"order": order}
+		}
+	}
+
+	var items []interface{}
+	i := start
+	for i < len(lines) {
+		var text string
+		if ordered {
+			m := orderedPattern.FindStringSubmatch(lines[i])
+			if m == nil {
+				break
+			}
+			text = m[2]
+		} else {
+			m := bulletPattern.FindStringSubmatch(lines[i])
+			if m == nil {
+				break
+			}
+			text = m[1]
+		}
+		i++
+
+		var nested []string
+		for i < len(lines) {
+			if isBlank(lines[i]) {
+				// A blank line only continues the item when indented content follows.
+				if i+1 < len(lines) && strings.HasPrefix(lines[i+1], strings.Repeat(" ", listIndentWidth)) {
+					nested = append(nested, "")
+					i++
+					continue
+				}
+				break
+			}
+			if !strings.HasPrefix(lines[i], strings.Repeat(" ", listIndentWidth)) {
+				break
+			}
+			nested = append(nested, dedent(lines[i]))
+			i++
+		}
+
+		content := []interface{}{paragraph([]string{text})}
+		content = append(content, parseBlocks(nested)...)
+		items = append(items, Node{"type": "listItem", "content": content})
+
+		// Stop at a blank line followed by something that is not another item.
+		if i < len(lines) && isBlank(lines[i]) {
+			break
+		}
+	}
+
+	list["content"] = items
+	return list, i
+}
+
+func dedent(line string) string {
+	for n := 0; n < listIndentWidth && strings.HasPrefix(line, " "); n++ {
+		line = line[1:]
+	}
+	return line
+}
+
+// inlineDelimiters are the paired inline marks, longest first so ** wins over *.
+var inlineDelimiters = []struct {
+	token string
+	mark  string
+}{
+	{"**", "strong"},
+	{"__", "strong"},
+	{"~~", "strike"},
+	{"*", "em"},
+	{"_", "em"},
+}
+
+// parseInline converts a line of Markdown into text nodes with marks.
+func parseInline(text string) []interface{} {
+	return mergeText(parseInlineMarks(text, nil))
+}
+
+func parseInlineMarks(text string, marks []interface{}) []interface{} {
+	var nodes []interface{}
+	var literal strings.Builder
+
+	flush := func() {
+		if literal.Len() > 0 {
+			nodes = append(nodes, textNode(literal.String(), marks))
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(text); {
+		c := text[i]
+
+		if c == '\\' && i+1 < len(text) && strings.ContainsRune("\\`*_~[]()#>-+.!", rune(text[i+1])) {
+			literal.WriteByte(text[i+1])
+			i += 2
+			continue
+		}
+
+		if c == '`' {
+			if end := strings.IndexByte(text[i+1:], '`'); end >= 0 {
+				flush()
+				nodes = append(nodes, textNode(text[i+1:i+1+end], withMark(marks, Node{"type": "code"})))
+				i += end + 2
+				continue
+			}
+		}
+
+		if c == '[' {
+			if label, href, n, ok := parseLink(text[i:]); ok {
+				flush()
+				nodes = append(nodes, parseInlineMarks(label, withMark(marks, Node{"type": "link", "attrs": Node{"href": href}}))...)
+				i += n
+				continue
+			}
+		}
+
+		matched := false
+		for _, d := range inlineDelimiters {
+			if !strings.HasPrefix(text[i:], d.token) {
+				continue
+			}
+			rest := text[i+len(d.token):]
+			end := strings.Index(rest, d.token)
+			// Close at the end of a delimiter run, so ***text*** is strong around em.
+			for end > 0 && end+len(d.token) < len(rest) && rest[end+len(d.token)] == d.token[0] {
+				end++
+			}
+			// Require non-space content right inside the delimiters, as Markdown does.
+			if end <= 0 || rest[0] == ' ' || rest[end-1] == ' ' {
+				continue
+			}
+			// Underscores inside words (snake_case) are literal.
+			if d.token[0] == '_' && i > 0 && isWordByte(text[i-1]) {
+				continue
+			}
+			flush()
+			nodes = append(nodes, parseInlineMarks(rest[:end], withMark(marks, Node{"type": d.mark}))...)
+			i += len(d.token) + end + len(d.token)
+			matched = true
+			break
+		}
+		if matched {
+			continue
+		}
+
+		literal.WriteByte(c)
+		i++
+	}
+	flush()
+	return nodes
+}
+
+// parseLink parses [label](href) at the start of text and returns the number of bytes consumed.
+func parseLink(text string) (label, href string, n int, ok bool) {
+	closeLabel := strings.Index(text, "](")
+	if closeLabel < 0 {
+		return "", "", 0, false
+	}
+	closeHref := strings.IndexByte(text[closeLabel+2:], ')')
+	if closeHref < 0 {
+		return "", "", 0, false
+	}
+	label = text[1:closeLabel]
+	href = text[closeLabel+2 : closeLabel+2+closeHref]
+	if href == "" || strings.ContainsAny(href, " \t") {
+		return "", "", 0, false
+	}
+	return label, href, closeLabel + 2 + closeHref + 1, true
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+// withMark returns marks with mark added. ADF only allows the code mark together with a
+// link, so adding code drops the other marks.
+func withMark(marks []interface{}, mark Node) []interface{} {
+	result := make([]interface{}, 0, len(marks)+1)
+	for _, m := range marks {
+		if mark["type"] == "code" && m.(Node)["type"] != "link" {
+			continue
+		}
+		result = append(result, m)
+	}
+	return append(result, mark)
+}
+
+func textNode(text string, marks []interface{}) Node {
+	node := Node{"type": "text", "text": text}
+	if len(marks) > 0 {
+		node["marks"] = marks
+	}
+	return node
+}
+
+// mergeText joins adjacent text nodes that carry the same marks.
+func mergeText(nodes []interface{}) []interface{} {
+	var result []interface{}
+	for _, n := range nodes {
+		node := n.(Node)
+		if len(result) > 0 {
+			prev := result[len(result)-1].(Node)
+			if prev["type"] == "text" && node["type"] == "text" && Equal(prev["marks"], node["marks"]) {
+				prev["text"] = prev["text"].(string) + node["text"].(string)
+				continue
+			}
+		}
+		result = append(result, node)
+	}
+	return result
+}
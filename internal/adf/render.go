@@ -0,0 +1,178 @@
+package adf
+
+import (
+	"fmt"
+	"strings"
+)
+
+func renderBlocks(blocks []map[string]interface{}) string {
+	var parts []string
+	for _, block := range blocks {
+		parts = append(parts, renderBlock(block))
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+func renderBlock(block map[string]interface{}) string {
+	switch block["type"] {
+	case "paragraph":
+		return renderInline(children(block))
+
+	case "heading":
+		level := 1
+		if attrs, ok := block["attrs"].(map[string]interface{}); ok {
+			if l, ok := attrs["level"].(float64); ok {
+				level = int(l)
+			} else if l, ok := attrs["level"].(int); ok {
+				level = l
+			}
+		}
+		return strings.Repeat("#", level) + " " + renderInline(children(block))
+
+	case "rule":
+		return "---"
+
+	case "codeBlock":
+		language := ""
+		if attrs, ok := block["attrs"].(map[string]interface{}); ok {
+			language, _ = attrs["language"].(string)
+		}
+		var text strings.Builder
+		for _, child := range children(block) {
+			s, _ := child["text"].(string)
+			text.WriteString(s)
+		}
+		return "```" + language + "\n" + text.String() + "\n```"
+
+	case "blockquote":
+		var lines []string
+		for _, line := range strings.Split(renderBlocks(children(block)), "\n") {
+			lines = append(lines, strings.TrimRight("> "+line, " "))
+		}
+		return strings.Join(lines, "\n")
+
+	case "bulletList", "orderedList":
+		return renderList(block)
+
+	default:
+		// Panels, tables and other containers without a Markdown form keep their text.
+		if _, ok := block["text"]; ok {
+			return renderInline([]map[string]interface{}{block})
+		}
+		return renderBlocks(children(block))
+	}
+}
+
+func renderList(list map[string]interface{}) string {
+	ordered := list["type"] == "orderedList"
+	order := 1
+	if attrs, ok := list["attrs"].(map[string]interface{}); ok {
+		if o, ok := attrs["order"].(float64); ok {
+			order = int(o)
+		} else if o, ok := attrs["order"].(int); ok {
+			order = o
+		}
+	}
+
+	var lines []string
+	for n, item := range children(list) {
+		marker := "- "
+		if ordered {
+			marker = fmt.Sprintf("%d. ", order+n)
+		}
+		indent := strings.Repeat(" ", listIndentWidth)
+
+		blocks := children(item)
+		if len(blocks) == 0 {
+			lines = append(lines, strings.TrimRight(marker, " "))
+			continue
+		}
+		first := strings.Split(renderBlock(blocks[0]), "\n")
+		lines = append(lines, marker+first[0])
+		for _, l := range first[1:] {
+			lines = append(lines, indent+l)
+		}
+		for _, nested := range blocks[1:] {
+			for _, l := range strings.Split(renderBlock(nested), "\n") {
+				if l == "" {
+					lines = append(lines, "")
+				} else {
+					lines = append(lines, indent+l)
+				}
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func renderInline(nodes []map[string]interface{}) string {
+	var sb strings.Builder
+	for _, node := range nodes {
+		switch node["type"] {
+		case "text":
+			text, _ := node["text"].(string)
+			sb.WriteString(applyMarks(text, node["marks"]))
+		case "hardBreak":
+			sb.WriteString("\n")
+		case "mention":
+			if attrs, ok := node["attrs"].(map[string]interface{}); ok {
+				text, _ := attrs["text"].(string)
+				if !strings.HasPrefix(text, "@") {
+					text = "@" + text
+				}
+				sb.WriteString(text)
+			}
+		case "emoji":
+			if attrs, ok := node["attrs"].(map[string]interface{}); ok {
+				if text, ok := attrs["text"].(string); ok && text != "" {
+					sb.WriteString(text)
+				} else if name, ok := attrs["shortName"].(string); ok {
+					sb.WriteString(name)
+				}
+			}
+		case "inlineCard":
+			if attrs, ok := node["attrs"].(map[string]interface{}); ok {
+				if url, ok := attrs["url"].(string); ok {
+					sb.WriteString(url)
+				}
+			}
+		default:
+			sb.WriteString(renderInline(children(node)))
+		}
+	}
+	return sb.String()
+}
+
+// applyMarks wraps text in the Markdown syntax of its marks. Code is applied innermost
+// and links outermost, matching how the parser nests them.
+func applyMarks(text string, rawMarks interface{}) string {
+	marks, _ := rawMarks.([]interface{})
+	has := make(map[string]map[string]interface{})
+	for _, m := range marks {
+		if mark, ok := m.(map[string]interface{}); ok {
+			if t, ok := mark["type"].(string); ok {
+				has[t] = mark
+			}
+		}
+	}
+
+	if _, ok := has["code"]; ok {
+		text = "`" + text + "`"
+	}
+	if _, ok := has["em"]; ok {
+		text = "*" + text + "*"
+	}
+	if _, ok := has["strong"]; ok {
+		text = "**" + text + "**"
+	}
+	if _, ok := has["strike"]; ok {
+		text = "~~" + text + "~~"
+	}
+	if link, ok := has["link"]; ok {
+		if attrs, ok := link["attrs"].(map[string]interface{}); ok {
+			href, _ := attrs["href"].(string)
+			text = "[" + text + "](" + href + ")"
+		}
+	}
+	return text
+}
@@ -0,0 +1,153 @@
+// Package automation holds the handling of JIRA automation rule definitions shared by the
+// automation rule resource and data sources, so both report the same rule JSON.
+package automation
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// serverRuleFields are set by JIRA on every rule and cannot be changed through the API.
+var serverRuleFields = []string{
+	"id", "uuid", "ruleUuid", "ruleHome",
+	"created", "updated", "createdDate", "updatedDate",
+	"authorAccountId", "author",
+}
+
+// AttributeFields are the rule fields that the automation rule resource sets from its own
+// attributes, so they are not part of a copyable rule definition.
+var AttributeFields = []string{"name", "state", "ruleScopeARIs", "actor", "notifyOnError"}
+
+// serverComponentFields are set by JIRA on the trigger and on every component.
+var serverComponentFields = []string{"id", "parentId", "conditionParentId", "connectionId"}
+
+// RuleFromResponse returns the rule definition from a rule API response. The automation API
+// wraps the rule in a "rule" object, together with the connections it uses.
+func RuleFromResponse(result map[string]interface{}) map[string]interface{} {
+	if rule, ok := result["rule"].(map[string]interface{}); ok {
+		return rule
+	}
+	return result
+}
+
+// StripServerFields returns a copy of the rule without ids, timestamps and author, which JIRA
+// manages and would otherwise show up as drift.
+func StripServerFields(rule map[string]interface{}) map[string]interface{} {
+	result := copyWithout(rule, serverRuleFields)
+	if trigger, ok := result["trigger"].(map[string]interface{}); ok {
+		result["trigger"] = stripComponent(trigger)
+	}
+	if components, ok := result["components"].([]interface{}); ok {
+		result["components"] = stripComponents(components)
+	}
+	return result
+}
+
+// StripAttributeFields returns a copy of the rule without AttributeFields, so a rule copied
+// from another rule takes its name, state, scope, actor and notifications from the copy.
+func StripAttributeFields(rule map[string]interface{}) map[string]interface{} {
+	return copyWithout(rule, AttributeFields)
+}
+
+func stripComponents(components []interface{}) []interface{} {
+	result := make([]interface{}, len(components))
+	for i, c := range components {
+		if component, ok := c.(map[string]interface{}); ok {
+			result[i] = stripComponent(component)
+		} else {
+			result[i] = c
+		}
+	}
+	return result
+}
+
+// stripComponent removes server fields from a component and from the components nested in
+// its branches and conditions. The component's config value is left as is.
+func stripComponent(component map[string]interface{}) map[string]interface{} {
+	result := copyWithout(component, serverComponentFields)
+	for _, key := range []string{"children", "conditions"} {
+		if nested, ok := result[key].([]interface{}); ok {
+			result[key] = stripComponents(nested)
+		}
+	}
+	return result
+}
+
+func copyWithout(m map[string]interface{}, keys []string) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	for _, k := range keys {
+		delete(result, k)
+	}
+	return result
+}
+
+// Project returns the parts of remote that the configured document sets. Objects keep only
+// the configured keys, so defaults JIRA adds to a rule are ignored, while changed values,
+// removed keys and added or removed array elements still show up as differences.
+func Project(remote, configured interface{}) interface{} {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		r, ok := remote.(map[string]interface{})
+		if !ok {
+			return remote
+		}
+		result := make(map[string]interface{}, len(c))
+		for k, cv := range c {
+			if rv, ok := r[k]; ok {
+				result[k] = Project(rv, cv)
+			}
+		}
+		return result
+	case []interface{}:
+		r, ok := remote.([]interface{})
+		if !ok {
+			return remote
+		}
+		result := make([]interface{}, len(r))
+		for i, rv := range r {
+			if i < len(c) {
+				result[i] = Project(rv, c[i])
+			} else {
+				result[i] = rv
+			}
+		}
+		return result
+	default:
+		return remote
+	}
+}
+
+// Marshal encodes a rule definition as compact JSON with sorted keys.
+func Marshal(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// RuleID returns the UUID of a rule or rule summary.
+func RuleID(rule map[string]interface{}) string {
+	for _, key := range []string{"uuid", "ruleUuid", "id"} {
+		if v, ok := rule[key]; ok && v != nil {
+			return fmt.Sprintf("%v", v)
+		}
+	}
+	return ""
+}
+
+// StringList returns the elements of a JSON array field as strings, e.g. the scope ARIs
+// or labels of a rule.
+func StringList(rule map[string]interface{}, key string) []string {
+	raw, _ := rule[key].([]interface{})
+	result := make([]string, 0, len(raw))
+	for _, item := range raw {
+		if item != nil {
+			result = append(result, fmt.Sprintf("%v", item))
+		}
+	}
+	return result
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DefaultAutomationBaseURL is where the JIRA Cloud automation API lives. Unlike the REST API,
+// it is not served from the site URL but from the Atlassian API gateway, per cloud ID.
+const DefaultAutomationBaseURL = "https://api.atlassian.com/automation/public/jira"
+
+// CloudID returns the cloud ID of the JIRA site, discovered once through /_edge/tenant_info.
+func (c *Client) CloudID() (string, error) {
+	c.cloudIDMu.Lock()
+	defer c.cloudIDMu.Unlock()
+
+	if c.cloudID != "" {
+		return c.cloudID, nil
+	}
+
+	var info struct {
+		CloudID string `json:"cloudId"`
+	}
+	if err := c.Get("/_edge/tenant_info", &info); err != nil {
+		return "", fmt.Errorf("failed to discover cloud ID: %w", err)
+	}
+	if info.CloudID == "" {
+		return "", fmt.Errorf("failed to discover cloud ID: /_edge/tenant_info returned no cloudId")
+	}
+	c.cloudID = info.CloudID
+	return c.cloudID, nil
+}
+
+// ProjectARI returns the ARI of a project, as used in automation rule scopes.
+func (c *Client) ProjectARI(projectID string) (string, error) {
+	cloudID, err := c.CloudID()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("ari:cloud:jira:%s:project/%s", cloudID, projectID), nil
+}
+
+// SiteARI returns the ARI of the JIRA site, the scope of global automation rules.
+func (c *Client) SiteARI() (string, error) {
+	cloudID, err := c.CloudID()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("ari:cloud:jira::site/%s", cloudID), nil
+}
+
+func (c *Client) doAutomationRequest(method, path string, body interface{}, result interface{}) error {
+	cloudID, err := c.CloudID()
+	if err != nil {
+		return err
+	}
+	return c.doRequestURL(method, fmt.Sprintf("%s/%s%s", c.AutomationBaseURL, cloudID, path), body, result)
+}
+
+// AutomationGet sends a GET request to the automation API.
+func (c *Client) AutomationGet(path string, result interface{}) error {
+	return c.doAutomationRequest(http.MethodGet, path, nil, result)
+}
+
+// AutomationPost sends a POST request to the automation API.
+func (c *Client) AutomationPost(path string, body interface{}, result interface{}) error {
+	return c.doAutomationRequest(http.MethodPost, path, body, result)
+}
+
+// AutomationPut sends a PUT request to the automation API.
+func (c *Client) AutomationPut(path string, body interface{}, result interface{}) error {
+	return c.doAutomationRequest(http.MethodPut, path, body, result)
+}
+
+// AutomationDelete sends a DELETE request to the automation API.
+func (c *Client) AutomationDelete(path string) error {
+	return c.doAutomationRequest(http.MethodDelete, path, nil, nil)
+}
+
+// AutomationRulePageSize is the number of rule summaries requested per page.
+const AutomationRulePageSize = 100
+
+// AutomationRuleSummaries returns the summaries of all automation rules of the site, walking
+// the cursor-based pages of /rest/v1/rule/summary.
+func (c *Client) AutomationRuleSummaries() ([]map[string]interface{}, error) {
+	var all []map[string]interface{}
+	cursor := ""
+	for {
+		query := url.Values{"limit": {strconv.Itoa(AutomationRulePageSize)}}
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+
+		var p struct {
+			Data  []map[string]interface{} `json:"data"`
+			Links struct {
+				Next string `json:"next"`
+			} `json:"links"`
+		}
+		if err := c.AutomationGet("/rest/v1/rule/summary?"+query.Encode(), &p); err != nil {
+			return nil, err
+		}
+		all = append(all, p.Data...)
+
+		if p.Links.Next == "" || len(p.Data) == 0 {
+			break
+		}
+		next, err := url.Parse(p.Links.Next)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse next page link: %w", err)
+		}
+		cursor = next.Query().Get("cursor")
+		if cursor == "" {
+			break
+		}
+	}
+	return all, nil
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 	"time"
 )
 
@@ -17,6 +18,15 @@ type Client struct {
 	Email      string
 	APIToken   string
 	HTTPClient *http.Client
+
+	// AutomationBaseURL is the base URL of the automation API, without the cloud ID.
+	AutomationBaseURL string
+
+	cloudIDMu sync.Mutex
+	cloudID   string
+
+	projectPermissionsMu sync.Mutex
+	projectPermissions   []string
 }
 
 // NewClient creates a new JIRA API client.
@@ -28,6 +38,7 @@ func NewClient(baseURL, email, apiToken string) *Client {
 		HTTPClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		AutomationBaseURL: DefaultAutomationBaseURL,
 	}
 }
 
@@ -50,10 +61,13 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("JIRA API error (HTTP %d)", e.StatusCode)
 }
 
-// doRequest executes an HTTP request with authentication and error handling.
+// doRequest executes an HTTP request against the JIRA REST API.
 func (c *Client) doRequest(method, path string, body interface{}, result interface{}) error {
-	fullURL := c.BaseURL + path
+	return c.doRequestURL(method, c.BaseURL+path, body, result)
+}
 
+// doRequestURL executes an HTTP request with authentication and error handling.
+func (c *Client) doRequestURL(method, fullURL string, body interface{}, result interface{}) error {
 	var reqBody io.Reader
 	if body != nil {
 		jsonData, err := json.Marshal(body)
@@ -83,7 +97,7 @@ func (c *Client) doRequest(method, path string, body interface{}, result interfa
 		retryAfter := resp.Header.Get("Retry-After")
 		if seconds, err := strconv.Atoi(retryAfter); err == nil {
 			time.Sleep(time.Duration(seconds) * time.Second)
-			return c.doRequest(method, path, body, result)
+			return c.doRequestURL(method, fullURL, body, result)
 		}
 		return fmt.Errorf("rate limited by JIRA API, retry after: %s", retryAfter)
 	}
@@ -133,6 +147,12 @@ func (c *Client) Delete(path string) error {
 	return c.doRequest(http.MethodDelete, path, nil, nil)
 }
 
+// DeleteWithResult sends a DELETE request and decodes the response body.
+// Used for asynchronous deletes that answer with a task.
+func (c *Client) DeleteWithResult(path string, result interface{}) error {
+	return c.doRequest(http.MethodDelete, path, nil, result)
+}
+
 // DeleteWithQuery sends a DELETE request with query parameters.
 func (c *Client) DeleteWithQuery(path string, params url.Values) error {
 	if len(params) > 0 {
@@ -148,3 +168,12 @@ func IsNotFound(err error) bool {
 	}
 	return false
 }
+
+// IsMethodNotAllowed checks if the error is a 405 Method Not Allowed, which JIRA returns for
+// operations that are not available on an endpoint.
+func IsMethodNotAllowed(err error) bool {
+	if apiErr, ok := err.(*APIError); ok {
+		return apiErr.StatusCode == http.StatusMethodNotAllowed
+	}
+	return false
+}
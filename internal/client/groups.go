@@ -0,0 +1,64 @@
+package client
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// GroupMember is a user in a group, as listed by /rest/api/3/group/member.
+type GroupMember struct {
+	AccountID    string
+	DisplayName  string
+	EmailAddress string
+	Active       bool
+}
+
+// GroupMembers returns every member of a group across all pages. group selects the group
+// by groupId or groupname. Inactive users are only included when includeInactive is set.
+func (c *Client) GroupMembers(group url.Values, includeInactive bool) ([]GroupMember, error) {
+	params := url.Values{"includeInactiveUsers": {strconv.FormatBool(includeInactive)}}
+	for k, v := range group {
+		params[k] = v
+	}
+
+	values, err := c.GetAllPages("/rest/api/3/group/member", params)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]GroupMember, 0, len(values))
+	for _, v := range values {
+		accountID, _ := v["accountId"].(string)
+		if accountID == "" {
+			continue
+		}
+		member := GroupMember{AccountID: accountID}
+		if name, ok := v["displayName"].(string); ok {
+			member.DisplayName = name
+		}
+		if email, ok := v["emailAddress"].(string); ok {
+			member.EmailAddress = email
+		}
+		if active, ok := v["active"].(bool); ok {
+			member.Active = active
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// AddGroupMember adds a user to a group. group selects the group by groupId or groupname.
+func (c *Client) AddGroupMember(group url.Values, accountID string) error {
+	body := map[string]interface{}{"accountId": accountID}
+	return c.Post("/rest/api/3/group/user?"+group.Encode(), body, nil)
+}
+
+// RemoveGroupMember removes a user from a group. group selects the group by groupId or
+// groupname.
+func (c *Client) RemoveGroupMember(group url.Values, accountID string) error {
+	params := url.Values{"accountId": {accountID}}
+	for k, v := range group {
+		params[k] = v
+	}
+	return c.DeleteWithQuery("/rest/api/3/group/user", params)
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultPageSize is the maxResults value used when walking paginated endpoints.
+const DefaultPageSize = 50
+
+// page is the common envelope of JIRA paginated responses.
+type page struct {
+	StartAt    int                      `json:"startAt"`
+	MaxResults int                      `json:"maxResults"`
+	Total      int                      `json:"total"`
+	IsLast     *bool                    `json:"isLast"`
+	Values     []map[string]interface{} `json:"values"`
+}
+
+// GetAllPages walks a paginated endpoint that returns {startAt, maxResults, isLast, values}
+// and returns every value. Extra query parameters can be passed in params.
+func (c *Client) GetAllPages(path string, params url.Values) ([]map[string]interface{}, error) {
+	var all []map[string]interface{}
+	startAt := 0
+	for {
+		query := url.Values{}
+		for k, v := range params {
+			query[k] = v
+		}
+		query.Set("startAt", strconv.Itoa(startAt))
+		if query.Get("maxResults") == "" {
+			query.Set("maxResults", strconv.Itoa(DefaultPageSize))
+		}
+
+		sep := "?"
+		if strings.Contains(path, "?") {
+			sep = "&"
+		}
+
+		var p page
+		if err := c.Get(path+sep+query.Encode(), &p); err != nil {
+			return nil, err
+		}
+		all = append(all, p.Values...)
+
+		if len(p.Values) == 0 {
+			break
+		}
+		if p.IsLast != nil {
+			if *p.IsLast {
+				break
+			}
+		} else if p.Total > 0 && startAt+len(p.Values) >= p.Total {
+			break
+		}
+		startAt += len(p.Values)
+	}
+	return all, nil
+}
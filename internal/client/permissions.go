@@ -0,0 +1,37 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ProjectPermissionKeys returns the keys of all project permissions, including those added
+// by apps, as listed by /rest/api/3/permissions. The list is fetched once per client.
+func (c *Client) ProjectPermissionKeys() ([]string, error) {
+	c.projectPermissionsMu.Lock()
+	defer c.projectPermissionsMu.Unlock()
+
+	if c.projectPermissions != nil {
+		return c.projectPermissions, nil
+	}
+
+	var result struct {
+		Permissions map[string]struct {
+			Key  string `json:"key"`
+			Type string `json:"type"`
+		} `json:"permissions"`
+	}
+	if err := c.Get("/rest/api/3/permissions", &result); err != nil {
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+
+	keys := []string{}
+	for key, p := range result.Permissions {
+		if p.Type == "PROJECT" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	c.projectPermissions = keys
+	return keys, nil
+}
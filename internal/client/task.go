@@ -0,0 +1,63 @@
+package client
+
+import (
+	"fmt"
+	"time"
+)
+
+// TaskPollInterval is the delay between two polls of a long-running JIRA task.
+var TaskPollInterval = 2 * time.Second
+
+// TaskTimeout is the maximum time to wait for a long-running JIRA task to finish.
+var TaskTimeout = 10 * time.Minute
+
+// Task is the status of a long-running JIRA task as returned by /rest/api/3/task/{taskId}.
+type Task struct {
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// TaskIDFromResponse extracts the task ID from the response of an asynchronous operation.
+// JIRA answers with 303 See Other pointing at the task; the HTTP client follows the redirect,
+// so the decoded response is the task itself. An empty string means nothing was queued.
+func TaskIDFromResponse(result map[string]interface{}) string {
+	if result == nil {
+		return ""
+	}
+	if id, ok := result["taskId"]; ok && id != nil {
+		return fmt.Sprintf("%v", id)
+	}
+	if _, ok := result["status"]; ok {
+		if id, ok := result["id"]; ok && id != nil {
+			return fmt.Sprintf("%v", id)
+		}
+	}
+	return ""
+}
+
+// WaitForTask polls a long-running task until it completes, fails, or TaskTimeout elapses.
+func (c *Client) WaitForTask(taskID string) error {
+	deadline := time.Now().Add(TaskTimeout)
+	for {
+		var task Task
+		if err := c.Get(fmt.Sprintf("/rest/api/3/task/%s", taskID), &task); err != nil {
+			return fmt.Errorf("failed to read task %s: %w", taskID, err)
+		}
+
+		switch task.Status {
+		case "COMPLETE":
+			return nil
+		case "FAILED", "CANCELLED", "DEAD":
+			if task.Message != "" {
+				return fmt.Errorf("task %s %s: %s", taskID, task.Status, task.Message)
+			}
+			return fmt.Errorf("task %s %s", taskID, task.Status)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for task %s (last status: %s)", taskID, task.Status)
+		}
+		time.Sleep(TaskPollInterval)
+	}
+}
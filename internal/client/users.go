@@ -0,0 +1,29 @@
+package client
+
+import (
+	"net/url"
+	"strings"
+)
+
+// FindUsersByEmail searches users by email address through /rest/api/3/user/search and
+// returns the candidates for that address. Users whose email matches exactly are preferred.
+// When no email matches, for example because users hide their email, all search results
+// are returned, so callers can tell a unique match from an ambiguous one.
+func (c *Client) FindUsersByEmail(email string) ([]map[string]interface{}, error) {
+	params := url.Values{"query": {email}}
+	var users []map[string]interface{}
+	if err := c.Get("/rest/api/3/user/search?"+params.Encode(), &users); err != nil {
+		return nil, err
+	}
+
+	var exact []map[string]interface{}
+	for _, user := range users {
+		if address, ok := user["emailAddress"].(string); ok && strings.EqualFold(address, email) {
+			exact = append(exact, user)
+		}
+	}
+	if len(exact) > 0 {
+		return exact, nil
+	}
+	return users, nil
+}
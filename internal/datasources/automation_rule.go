@@ -0,0 +1,161 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/david/terraform-provider-jira/internal/automation"
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/david/terraform-provider-jira/internal/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AutomationRuleDataSource{}
+
+type AutomationRuleDataSource struct {
+	client *client.Client
+}
+
+type AutomationRuleDataSourceModel struct {
+	ID       types.String         `tfsdk:"id"`
+	Name     types.String         `tfsdk:"name"`
+	State    types.String         `tfsdk:"state"`
+	Scope    types.List           `tfsdk:"scope"`
+	Labels   types.List           `tfsdk:"labels"`
+	RuleJSON jsontypes.Normalized `tfsdk:"rule_json"`
+}
+
+func NewAutomationRuleDataSource() datasource.DataSource {
+	return &AutomationRuleDataSource{}
+}
+
+func (d *AutomationRuleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automation_rule"
+}
+
+func (d *AutomationRuleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a JIRA automation rule by UUID or name and returns its definition as normalized JSON.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The rule UUID. Either id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The rule name. Either id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The rule state: ENABLED or DISABLED.",
+				Computed:    true,
+			},
+			"scope": schema.ListAttribute{
+				Description: "ARIs of the projects the rule applies to, or the site ARI for a global rule.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"labels": schema.ListAttribute{
+				Description: "The rule labels.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"rule_json": schema.StringAttribute{
+				Description: "The rule definition as JSON, without the fields JIRA manages (ids, timestamps, author). Can be used as rule_json of a jira_automation_rule resource.",
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
+		},
+	}
+}
+
+func (d *AutomationRuleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *client.Client.")
+		return
+	}
+	d.client = c
+}
+
+func (d *AutomationRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AutomationRuleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError("Missing input", "Either id or name must be set.")
+		return
+	}
+	if hasID && hasName {
+		resp.Diagnostics.AddError("Ambiguous input", "Only one of id or name may be set.")
+		return
+	}
+
+	id := config.ID.ValueString()
+	if hasName {
+		summaries, err := d.client.AutomationRuleSummaries()
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing automation rules", err.Error())
+			return
+		}
+		var matches []string
+		for _, rule := range summaries {
+			if fmt.Sprintf("%v", rule["name"]) == config.Name.ValueString() {
+				matches = append(matches, automation.RuleID(rule))
+			}
+		}
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError("Automation rule not found",
+				fmt.Sprintf("No automation rule with name '%s' found.", config.Name.ValueString()))
+			return
+		}
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError("Multiple automation rules found",
+				fmt.Sprintf("Found %d automation rules with name '%s'. Use id instead.", len(matches), config.Name.ValueString()))
+			return
+		}
+		id = matches[0]
+	}
+
+	var response map[string]interface{}
+	if err := d.client.AutomationGet(fmt.Sprintf("/rest/v1/rule/%s", id), &response); err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Automation rule not found", fmt.Sprintf("No automation rule with id '%s' found.", id))
+			return
+		}
+		resp.Diagnostics.AddError("Error reading automation rule", err.Error())
+		return
+	}
+	rule := automation.RuleFromResponse(response)
+
+	ruleJSON, err := automation.Marshal(automation.StripAttributeFields(automation.StripServerFields(rule)))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading automation rule", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(id)
+	config.Name = types.StringValue(fmt.Sprintf("%v", rule["name"]))
+	ruleState, _ := rule["state"].(string)
+	config.State = types.StringValue(ruleState)
+	scope, diags := types.ListValueFrom(ctx, types.StringType, automation.StringList(rule, "ruleScopeARIs"))
+	resp.Diagnostics.Append(diags...)
+	config.Scope = scope
+	labels, diags := types.ListValueFrom(ctx, types.StringType, automation.StringList(rule, "labels"))
+	resp.Diagnostics.Append(diags...)
+	config.Labels = labels
+	config.RuleJSON = jsontypes.NewNormalizedValue(ruleJSON)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,195 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/automation"
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AutomationRulesDataSource{}
+
+type AutomationRulesDataSource struct {
+	client *client.Client
+}
+
+type AutomationRulesDataSourceModel struct {
+	NameContains types.String `tfsdk:"name_contains"`
+	State        types.String `tfsdk:"state"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	Label        types.String `tfsdk:"label"`
+	Rules        types.List   `tfsdk:"rules"`
+}
+
+var automationRuleSummaryObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":     types.StringType,
+		"name":   types.StringType,
+		"state":  types.StringType,
+		"scope":  types.ListType{ElemType: types.StringType},
+		"labels": types.ListType{ElemType: types.StringType},
+	},
+}
+
+func NewAutomationRulesDataSource() datasource.DataSource {
+	return &AutomationRulesDataSource{}
+}
+
+func (d *AutomationRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automation_rules"
+}
+
+func (d *AutomationRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists JIRA automation rules, optionally filtered by name, state, project and label.",
+		Attributes: map[string]schema.Attribute{
+			"name_contains": schema.StringAttribute{
+				Description: "Only return rules whose name contains this text, ignoring case.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only return rules in this state: ENABLED or DISABLED.",
+				Optional:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Only return rules that apply to this project, including global rules.",
+				Optional:    true,
+			},
+			"label": schema.StringAttribute{
+				Description: "Only return rules with this label.",
+				Optional:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "The matching rules, in the order returned by JIRA.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The rule UUID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The rule name.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The rule state: ENABLED or DISABLED.",
+							Computed:    true,
+						},
+						"scope": schema.ListAttribute{
+							Description: "ARIs of the projects the rule applies to, or the site ARI for a global rule.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"labels": schema.ListAttribute{
+							Description: "The rule labels.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AutomationRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *client.Client.")
+		return
+	}
+	d.client = c
+}
+
+func (d *AutomationRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AutomationRulesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	summaries, err := d.client.AutomationRuleSummaries()
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing automation rules", err.Error())
+		return
+	}
+
+	// A rule applies to a project when it is scoped to the project or to the whole site.
+	var projectScopes map[string]bool
+	if !config.ProjectID.IsNull() && config.ProjectID.ValueString() != "" {
+		projectARI, err := d.client.ProjectARI(config.ProjectID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing automation rules", err.Error())
+			return
+		}
+		siteARI, err := d.client.SiteARI()
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing automation rules", err.Error())
+			return
+		}
+		projectScopes = map[string]bool{projectARI: true, siteARI: true}
+	}
+
+	rules := []attr.Value{}
+	for _, rule := range summaries {
+		name := fmt.Sprintf("%v", rule["name"])
+		ruleState, _ := rule["state"].(string)
+		scope := automation.StringList(rule, "ruleScopeARIs")
+		labels := automation.StringList(rule, "labels")
+
+		if !config.NameContains.IsNull() && !strings.Contains(strings.ToLower(name), strings.ToLower(config.NameContains.ValueString())) {
+			continue
+		}
+		if !config.State.IsNull() && !strings.EqualFold(ruleState, config.State.ValueString()) {
+			continue
+		}
+		if projectScopes != nil && !anyIn(scope, projectScopes) {
+			continue
+		}
+		if !config.Label.IsNull() && !anyIn(labels, map[string]bool{config.Label.ValueString(): true}) {
+			continue
+		}
+
+		scopeList, diags := types.ListValueFrom(ctx, types.StringType, scope)
+		resp.Diagnostics.Append(diags...)
+		labelList, diags := types.ListValueFrom(ctx, types.StringType, labels)
+		resp.Diagnostics.Append(diags...)
+		obj, diags := types.ObjectValue(automationRuleSummaryObjectType.AttrTypes, map[string]attr.Value{
+			"id":     types.StringValue(automation.RuleID(rule)),
+			"name":   types.StringValue(name),
+			"state":  types.StringValue(ruleState),
+			"scope":  scopeList,
+			"labels": labelList,
+		})
+		resp.Diagnostics.Append(diags...)
+		rules = append(rules, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, diags := types.ListValue(automationRuleSummaryObjectType, rules)
+	resp.Diagnostics.Append(diags...)
+	config.Rules = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func anyIn(values []string, set map[string]bool) bool {
+	for _, v := range values {
+		if set[v] {
+			return true
+		}
+	}
+	return false
+}
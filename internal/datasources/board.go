@@ -0,0 +1,139 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &BoardDataSource{}
+
+type BoardDataSource struct {
+	client *client.Client
+}
+
+type BoardDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Type       types.String `tfsdk:"type"`
+	FilterID   types.String `tfsdk:"filter_id"`
+}
+
+func NewBoardDataSource() datasource.DataSource {
+	return &BoardDataSource{}
+}
+
+func (d *BoardDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_board"
+}
+
+func (d *BoardDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a JIRA Software agile board by name and project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The board ID.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The board name to search for.",
+				Required:    true,
+			},
+			"project_key": schema.StringAttribute{
+				Description: "The key of the project the board is located in. Narrows the search when several boards share a name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The board type (scrum, kanban or simple).",
+				Computed:    true,
+			},
+			"filter_id": schema.StringAttribute{
+				Description: "The ID of the filter that selects the board's issues.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *BoardDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *client.Client.")
+		return
+	}
+	d.client = c
+}
+
+func (d *BoardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config BoardDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name parameter is a substring match, so exact matches are filtered below.
+	params := url.Values{"name": {config.Name.ValueString()}}
+	if !config.ProjectKey.IsNull() && !config.ProjectKey.IsUnknown() && config.ProjectKey.ValueString() != "" {
+		params.Set("projectKeyOrId", config.ProjectKey.ValueString())
+	}
+
+	boards, err := d.client.GetAllPages("/rest/agile/1.0/board", params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error searching boards", err.Error())
+		return
+	}
+
+	var matches []map[string]interface{}
+	for _, b := range boards {
+		if strings.EqualFold(fmt.Sprintf("%v", b["name"]), config.Name.ValueString()) {
+			matches = append(matches, b)
+		}
+	}
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Board not found",
+			fmt.Sprintf("No board with name '%s' found.", config.Name.ValueString()))
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError("Multiple boards found",
+			fmt.Sprintf("Found %d boards with name '%s'. Set project_key to narrow the search.", len(matches), config.Name.ValueString()))
+		return
+	}
+	board := matches[0]
+
+	config.ID = types.StringValue(fmt.Sprintf("%v", board["id"]))
+	config.Name = types.StringValue(fmt.Sprintf("%v", board["name"]))
+	config.Type = types.StringValue(fmt.Sprintf("%v", board["type"]))
+	if location, ok := board["location"].(map[string]interface{}); ok {
+		if key, ok := location["projectKey"].(string); ok && key != "" {
+			config.ProjectKey = types.StringValue(key)
+		}
+	}
+	if config.ProjectKey.IsUnknown() {
+		config.ProjectKey = types.StringNull()
+	}
+
+	var boardConfig map[string]interface{}
+	err = d.client.Get(fmt.Sprintf("/rest/agile/1.0/board/%s/configuration", config.ID.ValueString()), &boardConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading board configuration", err.Error())
+		return
+	}
+	config.FilterID = types.StringNull()
+	if filter, ok := boardConfig["filter"].(map[string]interface{}); ok {
+		config.FilterID = types.StringValue(fmt.Sprintf("%v", filter["id"]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
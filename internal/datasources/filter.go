@@ -0,0 +1,141 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &FilterDataSource{}
+
+type FilterDataSource struct {
+	client *client.Client
+}
+
+type FilterDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	OwnerAccountID types.String `tfsdk:"owner_account_id"`
+	Description    types.String `tfsdk:"description"`
+	JQL            types.String `tfsdk:"jql"`
+	Favourite      types.Bool   `tfsdk:"favourite"`
+}
+
+func NewFilterDataSource() datasource.DataSource {
+	return &FilterDataSource{}
+}
+
+func (d *FilterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_filter"
+}
+
+func (d *FilterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a JIRA saved filter by name and owner.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The filter ID.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The filter name to search for.",
+				Required:    true,
+			},
+			"owner_account_id": schema.StringAttribute{
+				Description: "The account ID of the filter owner. Narrows the search when several owners have a filter with the same name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The filter description.",
+				Computed:    true,
+			},
+			"jql": schema.StringAttribute{
+				Description: "The JQL query of the filter.",
+				Computed:    true,
+			},
+			"favourite": schema.BoolAttribute{
+				Description: "Whether the filter is a favourite of the user the provider authenticates as.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *FilterDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *client.Client.")
+		return
+	}
+	d.client = c
+}
+
+func (d *FilterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config FilterDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// filterName is a case-insensitive substring match, so exact matches are filtered below.
+	params := url.Values{
+		"filterName": {config.Name.ValueString()},
+		"expand":     {"description,jql,owner,favourite"},
+	}
+	if !config.OwnerAccountID.IsNull() && !config.OwnerAccountID.IsUnknown() && config.OwnerAccountID.ValueString() != "" {
+		params.Set("accountId", config.OwnerAccountID.ValueString())
+	}
+
+	filters, err := d.client.GetAllPages("/rest/api/3/filter/search", params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error searching filters", err.Error())
+		return
+	}
+
+	var matches []map[string]interface{}
+	for _, f := range filters {
+		if strings.EqualFold(fmt.Sprintf("%v", f["name"]), config.Name.ValueString()) {
+			matches = append(matches, f)
+		}
+	}
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Filter not found",
+			fmt.Sprintf("No filter with name '%s' found.", config.Name.ValueString()))
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError("Multiple filters found",
+			fmt.Sprintf("Found %d filters with name '%s'. Set owner_account_id to narrow the search.", len(matches), config.Name.ValueString()))
+		return
+	}
+	filter := matches[0]
+
+	config.ID = types.StringValue(fmt.Sprintf("%v", filter["id"]))
+	config.Name = types.StringValue(fmt.Sprintf("%v", filter["name"]))
+	config.Description = types.StringValue("")
+	if desc, ok := filter["description"].(string); ok {
+		config.Description = types.StringValue(desc)
+	}
+	config.JQL = types.StringValue("")
+	if jql, ok := filter["jql"].(string); ok {
+		config.JQL = types.StringValue(jql)
+	}
+	favourite, _ := filter["favourite"].(bool)
+	config.Favourite = types.BoolValue(favourite)
+	config.OwnerAccountID = types.StringNull()
+	if owner, ok := filter["owner"].(map[string]interface{}); ok {
+		config.OwnerAccountID = types.StringValue(fmt.Sprintf("%v", owner["accountId"]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
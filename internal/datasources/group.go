@@ -6,6 +6,7 @@ import (
 	"net/url"
 
 	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,8 +19,20 @@ type GroupDataSource struct {
 }
 
 type GroupDataSourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	IncludeMembers  types.Bool   `tfsdk:"include_members"`
+	IncludeInactive types.Bool   `tfsdk:"include_inactive"`
+	Members         types.List   `tfsdk:"members"`
+}
+
+var groupMemberObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"account_id":    types.StringType,
+		"display_name":  types.StringType,
+		"email_address": types.StringType,
+		"active":        types.BoolType,
+	},
 }
 
 func NewGroupDataSource() datasource.DataSource {
@@ -44,6 +57,38 @@ func (d *GroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Optional:    true,
 				Computed:    true,
 			},
+			"include_members": schema.BoolAttribute{
+				Description: "Whether to return the members of the group. Defaults to false.",
+				Optional:    true,
+			},
+			"include_inactive": schema.BoolAttribute{
+				Description: "Whether members include inactive users. Only used with include_members. Defaults to false.",
+				Optional:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "The members of the group, across all pages. Only set when include_members is true.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Description: "The Atlassian account ID of the user.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The user's display name.",
+							Computed:    true,
+						},
+						"email_address": schema.StringAttribute{
+							Description: "The user's email address. Empty when the user hides it.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the user account is active.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -119,5 +164,29 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		config.ID = types.StringValue(fmt.Sprintf("%v", group["groupId"]))
 	}
 
+	config.Members = types.ListNull(groupMemberObjectType)
+	if config.IncludeMembers.ValueBool() {
+		members, err := d.client.GroupMembers(url.Values{"groupId": {config.ID.ValueString()}}, config.IncludeInactive.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading group members", err.Error())
+			return
+		}
+
+		values := make([]attr.Value, 0, len(members))
+		for _, m := range members {
+			obj, diags := types.ObjectValue(groupMemberObjectType.AttrTypes, map[string]attr.Value{
+				"account_id":    types.StringValue(m.AccountID),
+				"display_name":  types.StringValue(m.DisplayName),
+				"email_address": types.StringValue(m.EmailAddress),
+				"active":        types.BoolValue(m.Active),
+			})
+			resp.Diagnostics.Append(diags...)
+			values = append(values, obj)
+		}
+		list, diags := types.ListValue(groupMemberObjectType, values)
+		resp.Diagnostics.Append(diags...)
+		config.Members = list
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
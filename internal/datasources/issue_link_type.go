@@ -0,0 +1,107 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &IssueLinkTypeDataSource{}
+
+type IssueLinkTypeDataSource struct {
+	client *client.Client
+}
+
+type IssueLinkTypeDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Inward  types.String `tfsdk:"inward"`
+	Outward types.String `tfsdk:"outward"`
+}
+
+func NewIssueLinkTypeDataSource() datasource.DataSource {
+	return &IssueLinkTypeDataSource{}
+}
+
+func (d *IssueLinkTypeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_link_type"
+}
+
+func (d *IssueLinkTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a JIRA issue link type by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The issue link type ID.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The issue link type name to search for.",
+				Required:    true,
+			},
+			"inward": schema.StringAttribute{
+				Description: "The description of the inward link.",
+				Computed:    true,
+			},
+			"outward": schema.StringAttribute{
+				Description: "The description of the outward link.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *IssueLinkTypeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *client.Client.")
+		return
+	}
+	d.client = c
+}
+
+func (d *IssueLinkTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config IssueLinkTypeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var wrapper struct {
+		IssueLinkTypes []map[string]interface{} `json:"issueLinkTypes"`
+	}
+	err := d.client.Get("/rest/api/3/issueLinkType", &wrapper)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing issue link types", err.Error())
+		return
+	}
+
+	var linkType map[string]interface{}
+	wanted := config.Name.ValueString()
+	for _, lt := range wrapper.IssueLinkTypes {
+		if strings.EqualFold(fmt.Sprintf("%v", lt["name"]), wanted) {
+			linkType = lt
+			break
+		}
+	}
+	if linkType == nil {
+		resp.Diagnostics.AddError("Issue link type not found",
+			fmt.Sprintf("No issue link type with name '%s' found.", wanted))
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%v", linkType["id"]))
+	config.Name = types.StringValue(fmt.Sprintf("%v", linkType["name"]))
+	config.Inward = types.StringValue(fmt.Sprintf("%v", linkType["inward"]))
+	config.Outward = types.StringValue(fmt.Sprintf("%v", linkType["outward"]))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,129 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NotificationSchemeDataSource{}
+
+type NotificationSchemeDataSource struct {
+	client *client.Client
+}
+
+type NotificationSchemeDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func NewNotificationSchemeDataSource() datasource.DataSource {
+	return &NotificationSchemeDataSource{}
+}
+
+func (d *NotificationSchemeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_scheme"
+}
+
+func (d *NotificationSchemeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a JIRA notification scheme by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The notification scheme ID. Provide either id or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The notification scheme name. Provide either id or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The notification scheme description.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *NotificationSchemeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *client.Client.")
+		return
+	}
+	d.client = c
+}
+
+func (d *NotificationSchemeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config NotificationSchemeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && !config.ID.IsUnknown() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && !config.Name.IsUnknown() && config.Name.ValueString() != ""
+
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError("Missing input", "Either id or name must be specified.")
+		return
+	}
+	if hasID && hasName {
+		resp.Diagnostics.AddError("Ambiguous input", "Specify only one of id or name.")
+		return
+	}
+
+	var scheme map[string]interface{}
+
+	if hasID {
+		err := d.client.Get(fmt.Sprintf("/rest/api/3/notificationscheme/%s", config.ID.ValueString()), &scheme)
+		if err != nil {
+			if client.IsNotFound(err) {
+				resp.Diagnostics.AddError("Notification scheme not found",
+					fmt.Sprintf("No notification scheme with id '%s' found.", config.ID.ValueString()))
+				return
+			}
+			resp.Diagnostics.AddError("Error reading notification scheme", err.Error())
+			return
+		}
+	} else {
+		wanted := config.Name.ValueString()
+		schemes, err := d.client.GetAllPages("/rest/api/3/notificationscheme", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing notification schemes", err.Error())
+			return
+		}
+		for _, s := range schemes {
+			if strings.EqualFold(fmt.Sprintf("%v", s["name"]), wanted) {
+				scheme = s
+				break
+			}
+		}
+		if scheme == nil {
+			resp.Diagnostics.AddError("Notification scheme not found",
+				fmt.Sprintf("No notification scheme with name '%s' found.", config.Name.ValueString()))
+			return
+		}
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%v", scheme["id"]))
+	config.Name = types.StringValue(fmt.Sprintf("%v", scheme["name"]))
+	if desc, ok := scheme["description"].(string); ok && desc != "" {
+		config.Description = types.StringValue(desc)
+	} else {
+		config.Description = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
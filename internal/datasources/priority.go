@@ -0,0 +1,146 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PriorityDataSource{}
+
+type PriorityDataSource struct {
+	client *client.Client
+}
+
+type PriorityDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	IconURL     types.String `tfsdk:"icon_url"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+}
+
+func NewPriorityDataSource() datasource.DataSource {
+	return &PriorityDataSource{}
+}
+
+func (d *PriorityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_priority"
+}
+
+func (d *PriorityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a JIRA priority by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The priority ID. Provide either id or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The priority name. Provide either id or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The priority description.",
+				Computed:    true,
+			},
+			"color": schema.StringAttribute{
+				Description: "The priority status color.",
+				Computed:    true,
+			},
+			"icon_url": schema.StringAttribute{
+				Description: "The URL of the priority icon.",
+				Computed:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default priority.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *PriorityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *client.Client.")
+		return
+	}
+	d.client = c
+}
+
+func (d *PriorityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config PriorityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && !config.ID.IsUnknown() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && !config.Name.IsUnknown() && config.Name.ValueString() != ""
+
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError("Missing input", "Either id or name must be specified.")
+		return
+	}
+	if hasID && hasName {
+		resp.Diagnostics.AddError("Ambiguous input", "Specify only one of id or name.")
+		return
+	}
+
+	priorities, err := d.client.GetAllPages("/rest/api/3/priority/search", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing priorities", err.Error())
+		return
+	}
+
+	var priority map[string]interface{}
+	for _, p := range priorities {
+		if hasID && fmt.Sprintf("%v", p["id"]) == config.ID.ValueString() {
+			priority = p
+			break
+		}
+		if hasName && strings.EqualFold(fmt.Sprintf("%v", p["name"]), config.Name.ValueString()) {
+			priority = p
+			break
+		}
+	}
+	if priority == nil {
+		if hasID {
+			resp.Diagnostics.AddError("Priority not found", fmt.Sprintf("No priority with id '%s' found.", config.ID.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("Priority not found", fmt.Sprintf("No priority with name '%s' found.", config.Name.ValueString()))
+		}
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%v", priority["id"]))
+	config.Name = types.StringValue(fmt.Sprintf("%v", priority["name"]))
+	config.Description = types.StringValue("")
+	if desc, ok := priority["description"].(string); ok {
+		config.Description = types.StringValue(desc)
+	}
+	config.Color = types.StringValue("")
+	if color, ok := priority["statusColor"].(string); ok {
+		config.Color = types.StringValue(color)
+	}
+	config.IconURL = types.StringValue("")
+	if icon, ok := priority["iconUrl"].(string); ok {
+		config.IconURL = types.StringValue(icon)
+	}
+	isDefault, _ := priority["isDefault"].(bool)
+	config.IsDefault = types.BoolValue(isDefault)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,152 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PrioritySchemeDataSource{}
+
+type PrioritySchemeDataSource struct {
+	client *client.Client
+}
+
+type PrioritySchemeDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	DefaultPriorityID types.String `tfsdk:"default_priority_id"`
+	PriorityIDs       types.List   `tfsdk:"priority_ids"`
+}
+
+func NewPrioritySchemeDataSource() datasource.DataSource {
+	return &PrioritySchemeDataSource{}
+}
+
+func (d *PrioritySchemeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_priority_scheme"
+}
+
+func (d *PrioritySchemeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a JIRA priority scheme by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The priority scheme ID. Provide either id or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The priority scheme name. Provide either id or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The priority scheme description.",
+				Computed:    true,
+			},
+			"default_priority_id": schema.StringAttribute{
+				Description: "The ID of the default priority.",
+				Computed:    true,
+			},
+			"priority_ids": schema.ListAttribute{
+				Description: "IDs of the priorities in this scheme.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *PrioritySchemeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *client.Client.")
+		return
+	}
+	d.client = c
+}
+
+func (d *PrioritySchemeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config PrioritySchemeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && !config.ID.IsUnknown() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && !config.Name.IsUnknown() && config.Name.ValueString() != ""
+
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError("Missing input", "Either id or name must be specified.")
+		return
+	}
+	if hasID && hasName {
+		resp.Diagnostics.AddError("Ambiguous input", "Specify only one of id or name.")
+		return
+	}
+
+	schemes, err := d.client.GetAllPages("/rest/api/3/priorityscheme", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing priority schemes", err.Error())
+		return
+	}
+
+	var scheme map[string]interface{}
+	for _, s := range schemes {
+		if hasID && fmt.Sprintf("%v", s["id"]) == config.ID.ValueString() {
+			scheme = s
+			break
+		}
+		if hasName && strings.EqualFold(fmt.Sprintf("%v", s["name"]), config.Name.ValueString()) {
+			scheme = s
+			break
+		}
+	}
+	if scheme == nil {
+		if hasID {
+			resp.Diagnostics.AddError("Priority scheme not found", fmt.Sprintf("No priority scheme with id '%s' found.", config.ID.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("Priority scheme not found", fmt.Sprintf("No priority scheme with name '%s' found.", config.Name.ValueString()))
+		}
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%v", scheme["id"]))
+	config.Name = types.StringValue(fmt.Sprintf("%v", scheme["name"]))
+	config.Description = types.StringValue("")
+	if desc, ok := scheme["description"].(string); ok {
+		config.Description = types.StringValue(desc)
+	}
+
+	priorities, err := d.client.GetAllPages(fmt.Sprintf("/rest/api/3/priorityscheme/%s/priorities", config.ID.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing priority scheme priorities", err.Error())
+		return
+	}
+	ids := []string{}
+	config.DefaultPriorityID = types.StringValue("")
+	if def, ok := scheme["defaultPriorityId"]; ok && def != nil {
+		config.DefaultPriorityID = types.StringValue(fmt.Sprintf("%v", def))
+	}
+	for _, p := range priorities {
+		ids = append(ids, fmt.Sprintf("%v", p["id"]))
+		if isDefault, ok := p["isDefault"].(bool); ok && isDefault && config.DefaultPriorityID.ValueString() == "" {
+			config.DefaultPriorityID = types.StringValue(fmt.Sprintf("%v", p["id"]))
+		}
+	}
+	listVal, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.PriorityIDs = listVal
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,123 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ProjectCategoryDataSource{}
+
+type ProjectCategoryDataSource struct {
+	client *client.Client
+}
+
+type ProjectCategoryDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func NewProjectCategoryDataSource() datasource.DataSource {
+	return &ProjectCategoryDataSource{}
+}
+
+func (d *ProjectCategoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_category"
+}
+
+func (d *ProjectCategoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a JIRA project category by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The project category ID. Provide either id or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The project category name. Provide either id or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The project category description.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ProjectCategoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *client.Client.")
+		return
+	}
+	d.client = c
+}
+
+func (d *ProjectCategoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectCategoryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && !config.ID.IsUnknown() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && !config.Name.IsUnknown() && config.Name.ValueString() != ""
+
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError("Missing input", "Either id or name must be specified.")
+		return
+	}
+	if hasID && hasName {
+		resp.Diagnostics.AddError("Ambiguous input", "Specify only one of id or name.")
+		return
+	}
+
+	// GET /projectCategory is not paginated and returns every category.
+	var categories []map[string]interface{}
+	err := d.client.Get("/rest/api/3/projectCategory", &categories)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing project categories", err.Error())
+		return
+	}
+
+	var category map[string]interface{}
+	for _, c := range categories {
+		if hasID && fmt.Sprintf("%v", c["id"]) == config.ID.ValueString() {
+			category = c
+			break
+		}
+		if hasName && strings.EqualFold(fmt.Sprintf("%v", c["name"]), config.Name.ValueString()) {
+			category = c
+			break
+		}
+	}
+	if category == nil {
+		if hasID {
+			resp.Diagnostics.AddError("Project category not found", fmt.Sprintf("No project category with id '%s' found.", config.ID.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("Project category not found", fmt.Sprintf("No project category with name '%s' found.", config.Name.ValueString()))
+		}
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%v", category["id"]))
+	config.Name = types.StringValue(fmt.Sprintf("%v", category["name"]))
+	config.Description = types.StringValue("")
+	if desc, ok := category["description"].(string); ok {
+		config.Description = types.StringValue(desc)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
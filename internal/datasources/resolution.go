@@ -0,0 +1,128 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ResolutionDataSource{}
+
+type ResolutionDataSource struct {
+	client *client.Client
+}
+
+type ResolutionDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+}
+
+func NewResolutionDataSource() datasource.DataSource {
+	return &ResolutionDataSource{}
+}
+
+func (d *ResolutionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resolution"
+}
+
+func (d *ResolutionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a JIRA resolution by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The resolution ID. Provide either id or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The resolution name. Provide either id or name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The resolution description.",
+				Computed:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default resolution.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ResolutionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *client.Client.")
+		return
+	}
+	d.client = c
+}
+
+func (d *ResolutionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ResolutionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && !config.ID.IsUnknown() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && !config.Name.IsUnknown() && config.Name.ValueString() != ""
+
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError("Missing input", "Either id or name must be specified.")
+		return
+	}
+	if hasID && hasName {
+		resp.Diagnostics.AddError("Ambiguous input", "Specify only one of id or name.")
+		return
+	}
+
+	resolutions, err := d.client.GetAllPages("/rest/api/3/resolution/search", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing resolutions", err.Error())
+		return
+	}
+
+	var resolution map[string]interface{}
+	for _, res := range resolutions {
+		if hasID && fmt.Sprintf("%v", res["id"]) == config.ID.ValueString() {
+			resolution = res
+			break
+		}
+		if hasName && strings.EqualFold(fmt.Sprintf("%v", res["name"]), config.Name.ValueString()) {
+			resolution = res
+			break
+		}
+	}
+	if resolution == nil {
+		if hasID {
+			resp.Diagnostics.AddError("Resolution not found", fmt.Sprintf("No resolution with id '%s' found.", config.ID.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("Resolution not found", fmt.Sprintf("No resolution with name '%s' found.", config.Name.ValueString()))
+		}
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%v", resolution["id"]))
+	config.Name = types.StringValue(fmt.Sprintf("%v", resolution["name"]))
+	config.Description = types.StringValue("")
+	if desc, ok := resolution["description"].(string); ok {
+		config.Description = types.StringValue(desc)
+	}
+	isDefault, _ := resolution["isDefault"].(bool)
+	config.IsDefault = types.BoolValue(isDefault)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
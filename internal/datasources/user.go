@@ -94,9 +94,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 	} else if !config.EmailAddress.IsNull() && !config.EmailAddress.IsUnknown() && config.EmailAddress.ValueString() != "" {
 		// Search by email
-		params := url.Values{"query": {config.EmailAddress.ValueString()}}
-		var users []map[string]interface{}
-		err := d.client.Get("/rest/api/3/user/search?"+params.Encode(), &users)
+		users, err := d.client.FindUsersByEmail(config.EmailAddress.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Error searching for user by email", err.Error())
 			return
@@ -106,6 +104,11 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 				fmt.Sprintf("No user found with email '%s'.", config.EmailAddress.ValueString()))
 			return
 		}
+		if len(users) > 1 {
+			resp.Diagnostics.AddError("Multiple users found",
+				fmt.Sprintf("%d users match email '%s'. Use account_id instead.", len(users), config.EmailAddress.ValueString()))
+			return
+		}
 		user = users[0]
 	} else {
 		resp.Diagnostics.AddError("Missing input",
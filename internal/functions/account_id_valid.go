@@ -0,0 +1,48 @@
+package functions
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &AccountIDValidFunction{}
+
+// accountIDPattern matches the Atlassian account ID formats: the legacy 24-digit hex ID,
+// the "<number>:<uuid>" format, and the "qm:<uuid>:<uuid>" format of service desk customers.
+var accountIDPattern = regexp.MustCompile(`^(?i:[0-9a-f]{24}|[0-9]+:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|qm:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$`)
+
+type AccountIDValidFunction struct{}
+
+func NewAccountIDValidFunction() function.Function {
+	return &AccountIDValidFunction{}
+}
+
+func (f *AccountIDValidFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "account_id_valid"
+}
+
+func (f *AccountIDValidFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check the format of an Atlassian account ID",
+		Description: "Returns true when the value has the format of an Atlassian account ID. The check is offline and does not confirm that the account exists.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "account_id",
+				Description: "The value to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *AccountIDValidFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var accountID string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &accountID))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, accountIDPattern.MatchString(accountID)))
+}
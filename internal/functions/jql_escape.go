@@ -0,0 +1,57 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &JQLEscapeFunction{}
+
+type JQLEscapeFunction struct{}
+
+func NewJQLEscapeFunction() function.Function {
+	return &JQLEscapeFunction{}
+}
+
+func (f *JQLEscapeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jql_escape"
+}
+
+func (f *JQLEscapeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Quote a value for use in JQL",
+		Description: "Returns the value as a double-quoted JQL string literal, escaping backslashes, quotes and line breaks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "The value to quote.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *JQLEscapeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, jqlQuote(value)))
+}
+
+var jqlReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+// jqlQuote returns value as a double-quoted JQL string literal.
+func jqlQuote(value string) string {
+	return `"` + jqlReplacer.Replace(value) + `"`
+}
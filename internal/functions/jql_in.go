@@ -0,0 +1,57 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &JQLInFunction{}
+
+type JQLInFunction struct{}
+
+func NewJQLInFunction() function.Function {
+	return &JQLInFunction{}
+}
+
+func (f *JQLInFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jql_in"
+}
+
+func (f *JQLInFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a JQL value list",
+		Description: "Returns a parenthesized, comma-separated list of quoted JQL string literals for use with the in and not in operators, e.g. (\"A\", \"B\").",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "values",
+				Description: "The values to include. Must not be empty.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *JQLInFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &values))
+	if resp.Error != nil {
+		return
+	}
+
+	// JQL rejects "in ()", so an empty list cannot produce a valid clause.
+	if len(values) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "values must contain at least one element.")
+		return
+	}
+
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = jqlQuote(v)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, "("+strings.Join(quoted, ", ")+")"))
+}
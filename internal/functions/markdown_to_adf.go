@@ -0,0 +1,51 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/david/terraform-provider-jira/internal/adf"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &MarkdownToADFFunction{}
+
+type MarkdownToADFFunction struct{}
+
+func NewMarkdownToADFFunction() function.Function {
+	return &MarkdownToADFFunction{}
+}
+
+func (f *MarkdownToADFFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "markdown_to_adf"
+}
+
+func (f *MarkdownToADFFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert Markdown to Atlassian Document Format",
+		Description: "Converts Markdown or plain text into an Atlassian Document Format (ADF) document, returned as a JSON string.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "markdown",
+				Description: "The Markdown or plain text to convert.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MarkdownToADFFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var markdown string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &markdown))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := json.Marshal(adf.FromMarkdown(markdown))
+	if err != nil {
+		resp.Error = function.NewFuncError("Error encoding ADF document: " + err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(doc)))
+}
@@ -0,0 +1,91 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ProjectKeyFromNameFunction{}
+
+// Project keys must start with an uppercase letter, contain only uppercase letters and
+// digits, and be between 2 and 10 characters long under JIRA's default key rules.
+const (
+	projectKeyMinLength = 2
+	projectKeyMaxLength = 10
+)
+
+type ProjectKeyFromNameFunction struct{}
+
+func NewProjectKeyFromNameFunction() function.Function {
+	return &ProjectKeyFromNameFunction{}
+}
+
+func (f *ProjectKeyFromNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "project_key_from_name"
+}
+
+func (f *ProjectKeyFromNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Derive a project key from a project name",
+		Description: "Derives a project key that follows JIRA's default key rules: an uppercase letter followed by uppercase letters or digits, 2 to 10 characters. " +
+			"Names with several words use the first character of each word; single words are truncated. Non-ASCII characters are dropped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The project name.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ProjectKeyFromNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	key := projectKeyFromName(name)
+	if len(key) < projectKeyMinLength {
+		resp.Error = function.NewArgumentFuncError(0,
+			"Cannot derive a project key from \""+name+"\": it needs at least two ASCII letters or digits, starting with a letter.")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, key))
+}
+
+func projectKeyFromName(name string) string {
+	words := strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9')
+	})
+	// A key must start with a letter, so leading words or digits without one are skipped.
+	for len(words) > 0 {
+		words[0] = strings.TrimLeft(words[0], "0123456789")
+		if words[0] != "" {
+			break
+		}
+		words = words[1:]
+	}
+	if len(words) == 0 {
+		return ""
+	}
+
+	var key string
+	if len(words) > 1 {
+		for _, w := range words {
+			key += w[:1]
+		}
+	}
+	// Single words, and initials that are too short, fall back to the first word.
+	if len(key) < projectKeyMinLength {
+		key = words[0]
+	}
+	if len(key) > projectKeyMaxLength {
+		key = key[:projectKeyMaxLength]
+	}
+	return key
+}
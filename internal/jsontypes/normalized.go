@@ -0,0 +1,126 @@
+// Package jsontypes provides a string attribute type for JSON documents that compares values
+// semantically, so whitespace and key order changes do not cause diffs.
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = NormalizedType{}
+	_ basetypes.StringValuableWithSemanticEquals = Normalized{}
+	_ xattr.ValidateableAttribute                = Normalized{}
+)
+
+// NormalizedType is the attribute type of Normalized values. Use it as the CustomType of a
+// string attribute.
+type NormalizedType struct {
+	basetypes.StringType
+}
+
+func (t NormalizedType) String() string {
+	return "jsontypes.NormalizedType"
+}
+
+func (t NormalizedType) ValueType(_ context.Context) attr.Value {
+	return Normalized{}
+}
+
+func (t NormalizedType) Equal(o attr.Type) bool {
+	other, ok := o.(NormalizedType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t NormalizedType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Normalized{StringValue: in}, nil
+}
+
+func (t NormalizedType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+// Normalized is a JSON document stored as a string. Two values are semantically equal when
+// they decode to the same JSON value.
+type Normalized struct {
+	basetypes.StringValue
+}
+
+func NewNormalizedNull() Normalized {
+	return Normalized{StringValue: basetypes.NewStringNull()}
+}
+
+func NewNormalizedUnknown() Normalized {
+	return Normalized{StringValue: basetypes.NewStringUnknown()}
+}
+
+func NewNormalizedValue(value string) Normalized {
+	return Normalized{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v Normalized) Type(_ context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+func (v Normalized) Equal(o attr.Value) bool {
+	other, ok := o.(Normalized)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values decode to the same JSON value.
+func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Normalized)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this to the provider developers.", v, newValuable))
+		return false, diags
+	}
+
+	var a, b interface{}
+	if err := json.Unmarshal([]byte(v.ValueString()), &a); err != nil {
+		return false, nil
+	}
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &b); err != nil {
+		return false, nil
+	}
+	return reflect.DeepEqual(a, b), nil
+}
+
+// ValidateAttribute rejects values that are not valid JSON.
+func (v Normalized) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON String Value",
+			fmt.Sprintf("A string value was provided that is not valid JSON.\n\nGiven value: %s", v.ValueString()))
+	}
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/david/terraform-provider-jira/internal/client"
 	"github.com/david/terraform-provider-jira/internal/datasources"
+	"github.com/david/terraform-provider-jira/internal/functions"
 	"github.com/david/terraform-provider-jira/internal/resources"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,6 +18,7 @@ import (
 )
 
 var _ provider.Provider = &JiraProvider{}
+var _ provider.ProviderWithFunctions = &JiraProvider{}
 
 // JiraProvider defines the JIRA Terraform provider.
 type JiraProvider struct {
@@ -132,6 +135,22 @@ func (p *JiraProvider) Resources(_ context.Context) []func() resource.Resource {
 		resources.NewAutomationRuleResource,
 		resources.NewGroupResource,
 		resources.NewGroupMembershipResource,
+		resources.NewGroupMembersResource,
+		resources.NewNotificationSchemeResource,
+		resources.NewIssueSecuritySchemeResource,
+		resources.NewPriorityResource,
+		resources.NewPrioritySchemeResource,
+		resources.NewResolutionResource,
+		resources.NewIssueLinkTypeResource,
+		resources.NewProjectCategoryResource,
+		resources.NewProjectVersionResource,
+		resources.NewBoardResource,
+		resources.NewFilterResource,
+		resources.NewDashboardResource,
+		resources.NewDashboardGadgetResource,
+		resources.NewWebhookResource,
+		resources.NewIssueResource,
+		resources.NewPermissionGrantResource,
 	}
 }
 
@@ -143,5 +162,25 @@ func (p *JiraProvider) DataSources(_ context.Context) []func() datasource.DataSo
 		datasources.NewPermissionSchemeDataSource,
 		datasources.NewIssueTypeSchemeDataSource,
 		datasources.NewGroupDataSource,
+		datasources.NewNotificationSchemeDataSource,
+		datasources.NewPriorityDataSource,
+		datasources.NewPrioritySchemeDataSource,
+		datasources.NewResolutionDataSource,
+		datasources.NewIssueLinkTypeDataSource,
+		datasources.NewProjectCategoryDataSource,
+		datasources.NewBoardDataSource,
+		datasources.NewFilterDataSource,
+		datasources.NewAutomationRulesDataSource,
+		datasources.NewAutomationRuleDataSource,
+	}
+}
+
+func (p *JiraProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewMarkdownToADFFunction,
+		functions.NewJQLEscapeFunction,
+		functions.NewJQLInFunction,
+		functions.NewProjectKeyFromNameFunction,
+		functions.NewAccountIDValidFunction,
 	}
 }
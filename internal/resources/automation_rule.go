@@ -4,30 +4,113 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/david/terraform-provider-jira/internal/automation"
 	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/david/terraform-provider-jira/internal/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &AutomationRuleResource{}
+var _ resource.ResourceWithValidateConfig = &AutomationRuleResource{}
+var _ resource.ResourceWithImportState = &AutomationRuleResource{}
+var _ resource.ResourceWithModifyPlan = &AutomationRuleResource{}
 
 type AutomationRuleResource struct {
 	client *client.Client
 }
 
 type AutomationRuleResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	State    types.String `tfsdk:"state"`
-	RuleJSON types.String `tfsdk:"rule_json"`
+	ID             types.String         `tfsdk:"id"`
+	Name           types.String         `tfsdk:"name"`
+	State          types.String         `tfsdk:"state"`
+	RuleJSON       jsontypes.Normalized `tfsdk:"rule_json"`
+	Trigger        types.Object         `tfsdk:"trigger"`
+	Components     types.List           `tfsdk:"components"`
+	Scope          types.List           `tfsdk:"scope"`
+	ActorAccountID types.String         `tfsdk:"actor_account_id"`
+	NotifyOnError  types.String         `tfsdk:"notify_on_error"`
+	DeleteBehavior types.String         `tfsdk:"delete_behavior"`
 }
 
+var automationTriggerObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":           types.StringType,
+		"schema_version": types.Int64Type,
+		"config":         jsontypes.NormalizedType{},
+	},
+}
+
+var automationChildObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"component":      types.StringType,
+		"type":           types.StringType,
+		"schema_version": types.Int64Type,
+		"config":         jsontypes.NormalizedType{},
+	},
+}
+
+var automationComponentObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"component":      types.StringType,
+		"type":           types.StringType,
+		"schema_version": types.Int64Type,
+		"config":         jsontypes.NormalizedType{},
+		"children":       types.ListType{ElemType: automationChildObjectType},
+	},
+}
+
+// automationTrigger is the trigger of a rule in the structured form.
+type automationTrigger struct {
+	Type          types.String         `tfsdk:"type"`
+	SchemaVersion types.Int64          `tfsdk:"schema_version"`
+	Config        jsontypes.Normalized `tfsdk:"config"`
+}
+
+// automationComponent is a top-level condition, action or branch in the structured form.
+type automationComponent struct {
+	Component     types.String         `tfsdk:"component"`
+	Type          types.String         `tfsdk:"type"`
+	SchemaVersion types.Int64          `tfsdk:"schema_version"`
+	Config        jsontypes.Normalized `tfsdk:"config"`
+	Children      types.List           `tfsdk:"children"`
+}
+
+// automationChild is a condition or action inside a branch.
+type automationChild struct {
+	Component     types.String         `tfsdk:"component"`
+	Type          types.String         `tfsdk:"type"`
+	SchemaVersion types.Int64          `tfsdk:"schema_version"`
+	Config        jsontypes.Normalized `tfsdk:"config"`
+}
+
+var automationNotifyOnErrorValues = []string{"FIRSTERROR", "EVERYERROR", "NEVER"}
+
+// automationScopeGlobal is the scope entry of rules that apply to all projects.
+const automationScopeGlobal = "global"
+
+const (
+	automationDeleteDisable = "disable"
+	automationDeleteDelete  = "delete"
+)
+
+// automationArchivedSuffix is appended to the name of rules that are only disabled on destroy,
+// so leftover rules are easy to find in the JIRA UI.
+const automationArchivedSuffix = " (archived by terraform)"
+
 func NewAutomationRuleResource() resource.Resource {
 	return &AutomationRuleResource{}
 }
@@ -36,9 +119,41 @@ func (r *AutomationRuleResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_automation_rule"
 }
 
+func automationComponentAttributes(kinds string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"component": schema.StringAttribute{
+			Description: "The kind of component: " + kinds + ".",
+			Required:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The component type, e.g. jira.issue.assign or jira.issue.condition.",
+			Required:    true,
+		},
+		"schema_version": schema.Int64Attribute{
+			Description: "The schema version of the component configuration. Defaults to 1.",
+			Optional:    true,
+		},
+		"config": schema.StringAttribute{
+			Description: "The component configuration as a JSON string, sent as the component value.",
+			Optional:    true,
+			CustomType:  jsontypes.NormalizedType{},
+		},
+	}
+}
+
 func (r *AutomationRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	componentAttributes := automationComponentAttributes("condition, action or branch")
+	componentAttributes["children"] = schema.ListNestedAttribute{
+		Description: "The conditions and actions run by a branch, in order. Only valid when component is branch.",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: automationComponentAttributes("condition or action"),
+		},
+	}
+
 	resp.Schema = schema.Schema{
-		Description: "Manages a JIRA automation rule. Note: JIRA Cloud does not support deleting automation rules via API. On destroy, the rule will be disabled instead.",
+		Description: "Manages a JIRA automation rule. The rule is defined either with rule_json or with the structured trigger and components attributes. " +
+			"On destroy, the rule is disabled and renamed, or deleted, depending on delete_behavior.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The automation rule UUID.",
@@ -58,8 +173,62 @@ func (r *AutomationRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Default:     stringdefault.StaticString("ENABLED"),
 			},
 			"rule_json": schema.StringAttribute{
-				Description: "The full rule definition as a JSON string. Tip: create a rule in the JIRA UI, retrieve it via the API, then use its JSON as a template.",
-				Required:    true,
+				Description: "The full rule definition as a JSON string. Tip: create a rule in the JIRA UI, retrieve it via the API, then use its JSON as a template. " +
+					"Formatting and key order are ignored, as are fields JIRA manages (ids, timestamps, author) and fields not set in the configuration. Conflicts with trigger.",
+				Optional:   true,
+				CustomType: jsontypes.NormalizedType{},
+			},
+			"trigger": schema.SingleNestedAttribute{
+				Description: "The event that starts the rule. Conflicts with rule_json.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The trigger type, e.g. jira.issue.event.trigger:created.",
+						Required:    true,
+					},
+					"schema_version": schema.Int64Attribute{
+						Description: "The schema version of the trigger configuration. Defaults to 1.",
+						Optional:    true,
+					},
+					"config": schema.StringAttribute{
+						Description: "The trigger configuration as a JSON string, sent as the trigger value.",
+						Optional:    true,
+						CustomType:  jsontypes.NormalizedType{},
+					},
+				},
+			},
+			"components": schema.ListNestedAttribute{
+				Description: "The conditions, actions and branches run after the trigger, in order. Requires trigger.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: componentAttributes,
+				},
+			},
+			"scope": schema.ListAttribute{
+				Description: "Where the rule applies: \"global\" for all projects, or one or more project IDs or project ARIs. " +
+					"Project IDs are expanded to ARIs with the cloud ID of the site. When not set, the scope comes from ruleScopeARIs in rule_json or from JIRA's default, and is read back. " +
+					"Cannot be combined with ruleScopeARIs in rule_json.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"actor_account_id": schema.StringAttribute{
+				Description: "Account ID of the user the rule's actions run as. Overrides the actor in rule_json.",
+				Optional:    true,
+			},
+			"notify_on_error": schema.StringAttribute{
+				Description: "When to email the rule owner about failures: FIRSTERROR, EVERYERROR or NEVER. Overrides the setting in rule_json.",
+				Optional:    true,
+			},
+			"delete_behavior": schema.StringAttribute{
+				Description: "What happens to the rule on destroy: disable (default) disables the rule and appends \"" + automationArchivedSuffix + "\" to its name; " +
+					"delete deletes the rule, falling back to disable where the delete endpoint is not available.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(automationDeleteDisable),
 			},
 		},
 	}
@@ -77,6 +246,266 @@ func (r *AutomationRuleResource) Configure(_ context.Context, req resource.Confi
 	r.client = c
 }
 
+func (r *AutomationRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AutomationRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.RuleJSON.IsUnknown() && !config.Trigger.IsUnknown() {
+		switch {
+		case config.RuleJSON.IsNull() && config.Trigger.IsNull():
+			resp.Diagnostics.AddError("Missing rule definition", "Either rule_json or trigger must be set.")
+		case !config.RuleJSON.IsNull() && !config.Trigger.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("trigger"), "Conflicting rule definition",
+				"rule_json and trigger cannot both be set. Use rule_json for the full rule definition, or trigger and components for the structured form.")
+		}
+	}
+	if !config.Components.IsNull() && config.Trigger.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("components"), "Missing trigger", "components can only be used together with trigger.")
+	}
+
+	if !config.NotifyOnError.IsNull() && !config.NotifyOnError.IsUnknown() {
+		if !containsString(automationNotifyOnErrorValues, config.NotifyOnError.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("notify_on_error"), "Invalid notify_on_error",
+				fmt.Sprintf("notify_on_error must be one of %s, got %q.", strings.Join(automationNotifyOnErrorValues, ", "), config.NotifyOnError.ValueString()))
+		}
+	}
+
+	if !config.DeleteBehavior.IsNull() && !config.DeleteBehavior.IsUnknown() {
+		if b := config.DeleteBehavior.ValueString(); b != automationDeleteDisable && b != automationDeleteDelete {
+			resp.Diagnostics.AddAttributeError(path.Root("delete_behavior"), "Invalid delete_behavior",
+				fmt.Sprintf("delete_behavior must be disable or delete, got %q.", b))
+		}
+	}
+
+	if !config.Scope.IsNull() && !config.RuleJSON.IsNull() && !config.RuleJSON.IsUnknown() {
+		var rule map[string]interface{}
+		if err := json.Unmarshal([]byte(config.RuleJSON.ValueString()), &rule); err == nil {
+			if _, ok := rule["ruleScopeARIs"]; ok {
+				resp.Diagnostics.AddAttributeError(path.Root("scope"), "Conflicting scope",
+					"scope and ruleScopeARIs in rule_json cannot both be set. Remove one of them.")
+			}
+		}
+	}
+
+	if !config.Scope.IsNull() && !config.Scope.IsUnknown() {
+		var scope []types.String
+		resp.Diagnostics.Append(config.Scope.ElementsAs(ctx, &scope, false)...)
+		for i, entry := range scope {
+			if entry.IsUnknown() {
+				continue
+			}
+			v := entry.ValueString()
+			if v == automationScopeGlobal && len(scope) > 1 {
+				resp.Diagnostics.AddAttributeError(path.Root("scope").AtListIndex(i), "Invalid scope",
+					"\"global\" cannot be combined with project scopes.")
+			} else if v != automationScopeGlobal && !isNumeric(v) && !strings.HasPrefix(v, "ari:") {
+				resp.Diagnostics.AddAttributeError(path.Root("scope").AtListIndex(i), "Invalid scope",
+					fmt.Sprintf("scope entries must be \"global\", a project ID or an ARI, got %q.", v))
+			}
+		}
+	}
+
+	if config.Components.IsNull() || config.Components.IsUnknown() {
+		return
+	}
+	var components []automationComponent
+	resp.Diagnostics.Append(config.Components.ElementsAs(ctx, &components, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, c := range components {
+		componentPath := path.Root("components").AtListIndex(i)
+		if c.Component.IsUnknown() {
+			continue
+		}
+		kind := c.Component.ValueString()
+		if kind != "condition" && kind != "action" && kind != "branch" {
+			resp.Diagnostics.AddAttributeError(componentPath.AtName("component"), "Invalid component",
+				fmt.Sprintf("component must be condition, action or branch, got %q.", kind))
+		}
+		if c.Children.IsNull() || c.Children.IsUnknown() {
+			continue
+		}
+		if kind != "branch" {
+			resp.Diagnostics.AddAttributeError(componentPath.AtName("children"), "Unexpected children",
+				fmt.Sprintf("Only branch components can have children, but this component is a %s.", kind))
+			continue
+		}
+		var children []automationChild
+		resp.Diagnostics.Append(c.Children.ElementsAs(ctx, &children, false)...)
+		for j, child := range children {
+			if child.Component.IsUnknown() {
+				continue
+			}
+			if k := child.Component.ValueString(); k != "condition" && k != "action" {
+				resp.Diagnostics.AddAttributeError(componentPath.AtName("children").AtListIndex(j).AtName("component"), "Invalid component",
+					fmt.Sprintf("component of a branch child must be condition or action, got %q.", k))
+			}
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ModifyPlan marks the scope as unknown when it is not configured and rule_json changes, since
+// the new rule_json may set a different scope through ruleScopeARIs.
+func (r *AutomationRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AutomationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || scopeConfigured(ctx, req.Config, &resp.Diagnostics) {
+		return
+	}
+
+	if plan.RuleJSON.IsNull() || state.RuleJSON.IsNull() {
+		return
+	}
+	if plan.RuleJSON.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("scope"), types.ListUnknown(types.StringType))...)
+		return
+	}
+	equal, diags := plan.RuleJSON.StringSemanticEquals(ctx, state.RuleJSON)
+	resp.Diagnostics.Append(diags...)
+	if !equal {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("scope"), types.ListUnknown(types.StringType))...)
+	}
+}
+
+// scopeConfigured reports whether scope is set in the configuration. The plan cannot tell,
+// because the computed scope carries the value from state when it is not configured.
+func scopeConfigured(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) bool {
+	var scope types.List
+	diags.Append(config.GetAttribute(ctx, path.Root("scope"), &scope)...)
+	return !scope.IsNull()
+}
+
+// buildRuleBody compiles the configured rule, from rule_json or from the structured form,
+// into the rule payload of the automation API. The scope attribute only overrides the scope
+// of the rule when overrideScope is set, that is when scope is configured.
+func (r *AutomationRuleResource) buildRuleBody(ctx context.Context, plan AutomationRuleResourceModel, overrideScope bool) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ruleBody := map[string]interface{}{}
+	if !plan.RuleJSON.IsNull() {
+		if err := json.Unmarshal([]byte(plan.RuleJSON.ValueString()), &ruleBody); err != nil {
+			diags.AddError("Error parsing rule_json", err.Error())
+			return nil, diags
+		}
+	}
+
+	if !plan.Trigger.IsNull() {
+		var trigger automationTrigger
+		diags.Append(plan.Trigger.As(ctx, &trigger, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		compiled, err := compileComponent("TRIGGER", trigger.Type, trigger.SchemaVersion, trigger.Config)
+		if err != nil {
+			diags.AddAttributeError(path.Root("trigger").AtName("config"), "Error parsing trigger config", err.Error())
+			return nil, diags
+		}
+		ruleBody["trigger"] = compiled
+
+		components := []interface{}{}
+		if !plan.Components.IsNull() {
+			var entries []automationComponent
+			diags.Append(plan.Components.ElementsAs(ctx, &entries, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			for i, c := range entries {
+				compiled, err := compileComponent(strings.ToUpper(c.Component.ValueString()), c.Type, c.SchemaVersion, c.Config)
+				if err != nil {
+					diags.AddAttributeError(path.Root("components").AtListIndex(i).AtName("config"), "Error parsing component config", err.Error())
+					return nil, diags
+				}
+				children := []interface{}{}
+				if !c.Children.IsNull() {
+					var childEntries []automationChild
+					diags.Append(c.Children.ElementsAs(ctx, &childEntries, false)...)
+					if diags.HasError() {
+						return nil, diags
+					}
+					for j, child := range childEntries {
+						compiledChild, err := compileComponent(strings.ToUpper(child.Component.ValueString()), child.Type, child.SchemaVersion, child.Config)
+						if err != nil {
+							diags.AddAttributeError(path.Root("components").AtListIndex(i).AtName("children").AtListIndex(j).AtName("config"),
+								"Error parsing component config", err.Error())
+							return nil, diags
+						}
+						children = append(children, compiledChild)
+					}
+				}
+				compiled["children"] = children
+				compiled["conditions"] = []interface{}{}
+				components = append(components, compiled)
+			}
+		}
+		ruleBody["components"] = components
+	}
+
+	ruleBody["name"] = plan.Name.ValueString()
+
+	if overrideScope && !plan.Scope.IsNull() && !plan.Scope.IsUnknown() {
+		var scope []string
+		diags.Append(plan.Scope.ElementsAs(ctx, &scope, false)...)
+		aris := make([]string, 0, len(scope))
+		for _, entry := range scope {
+			ari, err := r.scopeARI(entry)
+			if err != nil {
+				diags.AddAttributeError(path.Root("scope"), "Error resolving automation rule scope", err.Error())
+				return nil, diags
+			}
+			aris = append(aris, ari)
+		}
+		ruleBody["ruleScopeARIs"] = aris
+	}
+	if !plan.ActorAccountID.IsNull() && !plan.ActorAccountID.IsUnknown() {
+		ruleBody["actor"] = map[string]interface{}{
+			"type":  "ACCOUNT_ID",
+			"actor": plan.ActorAccountID.ValueString(),
+		}
+	}
+	if !plan.NotifyOnError.IsNull() && !plan.NotifyOnError.IsUnknown() {
+		ruleBody["notifyOnError"] = plan.NotifyOnError.ValueString()
+	}
+
+	return ruleBody, diags
+}
+
+func compileComponent(kind string, componentType types.String, schemaVersion types.Int64, config jsontypes.Normalized) (map[string]interface{}, error) {
+	component := map[string]interface{}{
+		"component":     kind,
+		"type":          componentType.ValueString(),
+		"schemaVersion": int64(1),
+	}
+	if !schemaVersion.IsNull() {
+		component["schemaVersion"] = schemaVersion.ValueInt64()
+	}
+	if !config.IsNull() {
+		var value interface{}
+		if err := json.Unmarshal([]byte(config.ValueString()), &value); err != nil {
+			return nil, err
+		}
+		component["value"] = value
+	}
+	return component, nil
+}
+
 func (r *AutomationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan AutomationRuleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -84,31 +513,45 @@ func (r *AutomationRuleResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	// Parse the rule JSON and set the name
-	var ruleBody map[string]interface{}
-	if err := json.Unmarshal([]byte(plan.RuleJSON.ValueString()), &ruleBody); err != nil {
-		resp.Diagnostics.AddError("Error parsing rule_json", err.Error())
+	ruleBody, diags := r.buildRuleBody(ctx, plan, scopeConfigured(ctx, req.Config, &resp.Diagnostics))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	ruleBody["name"] = plan.Name.ValueString()
 
 	var result map[string]interface{}
-	err := r.client.Post("/rest/v1/rule", ruleBody, &result)
+	err := r.client.AutomationPost("/rest/v1/rule", map[string]interface{}{"rule": ruleBody}, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating automation rule", err.Error())
 		return
 	}
 
-	if id, ok := result["id"]; ok {
-		plan.ID = types.StringValue(fmt.Sprintf("%v", id))
-	} else if ruleUuid, ok := result["ruleUuid"]; ok {
-		plan.ID = types.StringValue(fmt.Sprintf("%v", ruleUuid))
+	id := automation.RuleID(result)
+	if id == "" {
+		id = automation.RuleID(automation.RuleFromResponse(result))
 	}
+	if id == "" {
+		resp.Diagnostics.AddError("Error creating automation rule", "JIRA did not return a UUID for the new rule.")
+		return
+	}
+	plan.ID = types.StringValue(id)
 
-	// Set the desired state
+	// Without a configured scope, JIRA decides it (from rule_json or the default), so read it back.
+	if plan.Scope.IsUnknown() {
+		rule, err := r.fetchRule(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading automation rule", err.Error())
+			return
+		}
+		plan.Scope = r.readScope(ctx, types.ListNull(types.StringType), rule, &resp.Diagnostics)
+	}
+
+	// New rules are disabled. If enabling fails, the rule is still saved so it is not orphaned.
 	if plan.State.ValueString() == "ENABLED" {
-		stateBody := map[string]interface{}{"state": "ENABLED"}
-		_ = r.client.Put(fmt.Sprintf("/rest/v1/rule/%s/state", plan.ID.ValueString()), stateBody, nil)
+		if err := r.setRuleState(plan.ID.ValueString(), "ENABLED"); err != nil {
+			resp.Diagnostics.AddError("Error enabling automation rule", err.Error())
+			plan.State = types.StringValue("DISABLED")
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
@@ -121,8 +564,7 @@ func (r *AutomationRuleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	var result map[string]interface{}
-	err := r.client.Get(fmt.Sprintf("/rest/v1/rule/%s", state.ID.ValueString()), &result)
+	result, err := r.fetchRule(state.ID.ValueString())
 	if err != nil {
 		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -139,6 +581,39 @@ func (r *AutomationRuleResource) Read(ctx context.Context, req resource.ReadRequ
 		state.State = types.StringValue(ruleState)
 	}
 
+	// Without a definition in state (after import), the full rule is read into rule_json.
+	if !state.RuleJSON.IsNull() || state.Trigger.IsNull() {
+		ruleJSON, err := remoteRuleJSON(result, state.RuleJSON)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading automation rule", err.Error())
+			return
+		}
+		state.RuleJSON = ruleJSON
+	} else {
+		rule := automation.StripServerFields(result)
+		remoteTrigger, _ := rule["trigger"].(map[string]interface{})
+		state.Trigger = readAutomationTrigger(ctx, state.Trigger, remoteTrigger, &resp.Diagnostics)
+		remoteComponents, _ := rule["components"].([]interface{})
+		state.Components = readAutomationComponents(ctx, state.Components, remoteComponents, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state.Scope = r.readScope(ctx, state.Scope, result, &resp.Diagnostics)
+	if !state.ActorAccountID.IsNull() {
+		if actor, ok := result["actor"].(map[string]interface{}); ok {
+			if accountID, ok := actor["actor"].(string); ok {
+				state.ActorAccountID = types.StringValue(accountID)
+			}
+		}
+	}
+	if !state.NotifyOnError.IsNull() {
+		if notify, ok := result["notifyOnError"].(string); ok {
+			state.NotifyOnError = types.StringValue(notify)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -150,27 +625,35 @@ func (r *AutomationRuleResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update the rule definition
-	var ruleBody map[string]interface{}
-	if err := json.Unmarshal([]byte(plan.RuleJSON.ValueString()), &ruleBody); err != nil {
-		resp.Diagnostics.AddError("Error parsing rule_json", err.Error())
+	ruleBody, diags := r.buildRuleBody(ctx, plan, scopeConfigured(ctx, req.Config, &resp.Diagnostics))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	ruleBody["name"] = plan.Name.ValueString()
 
-	err := r.client.Put(fmt.Sprintf("/rest/v1/rule/%s", plan.ID.ValueString()), ruleBody, nil)
+	err := r.client.AutomationPut(fmt.Sprintf("/rest/v1/rule/%s", plan.ID.ValueString()), map[string]interface{}{"rule": ruleBody}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating automation rule", err.Error())
 		return
 	}
 
 	// Update state if needed
-	stateBody := map[string]interface{}{"state": plan.State.ValueString()}
-	err = r.client.Put(fmt.Sprintf("/rest/v1/rule/%s/state", plan.ID.ValueString()), stateBody, nil)
+	err = r.setRuleState(plan.ID.ValueString(), plan.State.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating automation rule state", err.Error())
 		return
 	}
 
+	// A changed rule_json may have moved the rule; read the scope back.
+	if plan.Scope.IsUnknown() {
+		rule, err := r.fetchRule(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading automation rule", err.Error())
+			return
+		}
+		plan.Scope = r.readScope(ctx, types.ListNull(types.StringType), rule, &resp.Diagnostics)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -181,15 +664,295 @@ func (r *AutomationRuleResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	// JIRA Cloud does not support deleting automation rules via API.
-	// Instead, disable the rule.
-	tflog.Warn(ctx, "JIRA Cloud does not support deleting automation rules via API. Disabling rule instead.",
-		map[string]interface{}{"rule_id": state.ID.ValueString()})
+	id := state.ID.ValueString()
 
-	stateBody := map[string]interface{}{"state": "DISABLED"}
-	err := r.client.Put(fmt.Sprintf("/rest/v1/rule/%s/state", state.ID.ValueString()), stateBody, nil)
+	// Rules must be disabled before they can be deleted, so both behaviors start here.
+	err := r.setRuleState(id, "DISABLED")
 	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
 		resp.Diagnostics.AddError("Error disabling automation rule", err.Error())
 		return
 	}
+
+	if state.DeleteBehavior.ValueString() == automationDeleteDelete {
+		err = r.client.AutomationDelete(fmt.Sprintf("/rest/v1/rule/%s", id))
+		if err == nil || client.IsNotFound(err) {
+			return
+		}
+		if !client.IsMethodNotAllowed(err) {
+			resp.Diagnostics.AddError("Error deleting automation rule", err.Error())
+			return
+		}
+		tflog.Warn(ctx, "The automation API does not allow deleting this rule. Disabling and renaming it instead.",
+			map[string]interface{}{"rule_id": id})
+	}
+
+	if err := r.archiveRule(id); err != nil {
+		resp.Diagnostics.AddError("Error archiving automation rule", err.Error())
+		return
+	}
+}
+
+func (r *AutomationRuleResource) setRuleState(id, ruleState string) error {
+	stateBody := map[string]interface{}{"state": ruleState}
+	return r.client.AutomationPut(fmt.Sprintf("/rest/v1/rule/%s/state", id), stateBody, nil)
+}
+
+// archiveRule appends automationArchivedSuffix to the name of a disabled rule.
+func (r *AutomationRuleResource) archiveRule(id string) error {
+	rule, err := r.fetchRule(id)
+	if err != nil {
+		return err
+	}
+	name, _ := rule["name"].(string)
+	if strings.HasSuffix(name, automationArchivedSuffix) {
+		return nil
+	}
+	ruleBody := automation.StripServerFields(rule)
+	ruleBody["name"] = name + automationArchivedSuffix
+	return r.client.AutomationPut(fmt.Sprintf("/rest/v1/rule/%s", id), map[string]interface{}{"rule": ruleBody}, nil)
+}
+
+// ImportState imports a rule by its UUID. The full rule definition is read into rule_json.
+func (r *AutomationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// delete_behavior only exists in Terraform; start from its default so the first plan
+	// after import does not update the rule.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_behavior"), automationDeleteDisable)...)
+}
+
+func (r *AutomationRuleResource) fetchRule(id string) (map[string]interface{}, error) {
+	var response map[string]interface{}
+	if err := r.client.AutomationGet(fmt.Sprintf("/rest/v1/rule/%s", id), &response); err != nil {
+		return nil, err
+	}
+	return automation.RuleFromResponse(response), nil
+}
+
+// scopeARI expands a scope entry into an ARI: "global" becomes the site ARI and project IDs
+// become project ARIs. ARIs are used as is.
+func (r *AutomationRuleResource) scopeARI(entry string) (string, error) {
+	switch {
+	case entry == automationScopeGlobal:
+		return r.client.SiteARI()
+	case isNumeric(entry):
+		return r.client.ProjectARI(entry)
+	default:
+		return entry, nil
+	}
+}
+
+// readScope returns the remote rule scope, spelled as in the prior state where an entry
+// expands to the same ARI, so configured project IDs and "global" do not show up as changes.
+func (r *AutomationRuleResource) readScope(ctx context.Context, prior types.List, rule map[string]interface{}, diags *diag.Diagnostics) types.List {
+	remote := make(map[string]bool)
+	var remoteOrder []string
+	if raw, ok := rule["ruleScopeARIs"].([]interface{}); ok {
+		for _, item := range raw {
+			if ari, ok := item.(string); ok && !remote[ari] {
+				remote[ari] = true
+				remoteOrder = append(remoteOrder, ari)
+			}
+		}
+	}
+
+	scope := []string{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var entries []string
+		diags.Append(prior.ElementsAs(ctx, &entries, false)...)
+		for _, entry := range entries {
+			ari, err := r.scopeARI(entry)
+			if err != nil {
+				diags.AddError("Error resolving automation rule scope", err.Error())
+				return prior
+			}
+			if remote[ari] {
+				scope = append(scope, entry)
+				delete(remote, ari)
+			}
+		}
+	}
+	for _, ari := range remoteOrder {
+		if remote[ari] {
+			scope = append(scope, ari)
+		}
+	}
+
+	list, d := types.ListValueFrom(ctx, types.StringType, scope)
+	diags.Append(d...)
+	return list
+}
+
+// remoteRuleJSON returns the remote rule definition without server-managed fields. When a
+// definition is already known, only the fields it sets are compared, so defaults JIRA adds
+// do not show up as drift. The fields set from the name, state, actor_account_id and
+// notify_on_error attributes are never compared: they keep the value of the known definition,
+// so a rule_json copied from another rule does not differ in them. ruleScopeARIs is only
+// sent from rule_json when scope is not configured, so it is compared like any other field.
+func remoteRuleJSON(rule map[string]interface{}, prior jsontypes.Normalized) (jsontypes.Normalized, error) {
+	stripped := automation.StripServerFields(rule)
+	var remote interface{} = automation.StripAttributeFields(stripped)
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var configured map[string]interface{}
+		if err := json.Unmarshal([]byte(prior.ValueString()), &configured); err == nil {
+			projected, _ := automation.Project(remote, configured).(map[string]interface{})
+			for _, key := range automation.AttributeFields {
+				if _, ok := configured[key]; !ok {
+					continue
+				}
+				if key == "ruleScopeARIs" {
+					if v, ok := stripped[key]; ok {
+						projected[key] = v
+					}
+					continue
+				}
+				projected[key] = configured[key]
+			}
+			remote = projected
+		}
+	}
+	ruleJSON, err := automation.Marshal(remote)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), err
+	}
+	return jsontypes.NewNormalizedValue(ruleJSON), nil
+}
+
+// readComponentConfig returns the remote component value, compared only on the fields the
+// prior configuration sets. Components that were not configured before keep their full value.
+func readComponentConfig(prior jsontypes.Normalized, known bool, value interface{}) jsontypes.Normalized {
+	if known && prior.IsNull() {
+		return prior
+	}
+	if !known && value == nil {
+		return jsontypes.NewNormalizedNull()
+	}
+	if known && !prior.IsUnknown() {
+		var configured interface{}
+		if err := json.Unmarshal([]byte(prior.ValueString()), &configured); err == nil {
+			value = automation.Project(value, configured)
+		}
+	}
+	config, err := automation.Marshal(value)
+	if err != nil {
+		return prior
+	}
+	return jsontypes.NewNormalizedValue(config)
+}
+
+// readSchemaVersion returns the remote schema version when the prior configuration set one.
+func readSchemaVersion(prior types.Int64, known bool, remote map[string]interface{}) types.Int64 {
+	if !known || prior.IsNull() {
+		return types.Int64Null()
+	}
+	if v, ok := remote["schemaVersion"].(float64); ok {
+		return types.Int64Value(int64(v))
+	}
+	return prior
+}
+
+func readAutomationTrigger(ctx context.Context, prior types.Object, remote map[string]interface{}, diags *diag.Diagnostics) types.Object {
+	if remote == nil {
+		return types.ObjectNull(automationTriggerObjectType.AttrTypes)
+	}
+
+	var priorTrigger automationTrigger
+	diags.Append(prior.As(ctx, &priorTrigger, basetypes.ObjectAsOptions{})...)
+
+	componentType, _ := remote["type"].(string)
+	obj, d := types.ObjectValue(automationTriggerObjectType.AttrTypes, map[string]attr.Value{
+		"type":           types.StringValue(componentType),
+		"schema_version": readSchemaVersion(priorTrigger.SchemaVersion, true, remote),
+		"config":         readComponentConfig(priorTrigger.Config, true, remote["value"]),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+// readAutomationComponents maps the remote components onto the configured ones by position.
+// Components that exist only remotely are read in full, so additions made in the UI show up.
+func readAutomationComponents(ctx context.Context, prior types.List, remote []interface{}, diags *diag.Diagnostics) types.List {
+	if len(remote) == 0 && prior.IsNull() {
+		return types.ListNull(automationComponentObjectType)
+	}
+
+	var priorComponents []automationComponent
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorComponents, false)...)
+	}
+
+	var values []attr.Value
+	for i, item := range remote {
+		rc, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		known := i < len(priorComponents)
+		var p automationComponent
+		if known {
+			p = priorComponents[i]
+		} else {
+			p.Children = types.ListNull(automationChildObjectType)
+		}
+
+		remoteChildren, _ := rc["children"].([]interface{})
+		children := readAutomationChildren(ctx, p.Children, remoteChildren, diags)
+
+		kind, _ := rc["component"].(string)
+		componentType, _ := rc["type"].(string)
+		obj, d := types.ObjectValue(automationComponentObjectType.AttrTypes, map[string]attr.Value{
+			"component":      types.StringValue(strings.ToLower(kind)),
+			"type":           types.StringValue(componentType),
+			"schema_version": readSchemaVersion(p.SchemaVersion, known, rc),
+			"config":         readComponentConfig(p.Config, known, rc["value"]),
+			"children":       children,
+		})
+		diags.Append(d...)
+		values = append(values, obj)
+	}
+
+	list, d := types.ListValue(automationComponentObjectType, values)
+	diags.Append(d...)
+	return list
+}
+
+func readAutomationChildren(ctx context.Context, prior types.List, remote []interface{}, diags *diag.Diagnostics) types.List {
+	if len(remote) == 0 && prior.IsNull() {
+		return types.ListNull(automationChildObjectType)
+	}
+
+	var priorChildren []automationChild
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorChildren, false)...)
+	}
+
+	var values []attr.Value
+	for i, item := range remote {
+		rc, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		known := i < len(priorChildren)
+		var p automationChild
+		if known {
+			p = priorChildren[i]
+		}
+
+		kind, _ := rc["component"].(string)
+		componentType, _ := rc["type"].(string)
+		obj, d := types.ObjectValue(automationChildObjectType.AttrTypes, map[string]attr.Value{
+			"component":      types.StringValue(strings.ToLower(kind)),
+			"type":           types.StringValue(componentType),
+			"schema_version": readSchemaVersion(p.SchemaVersion, known, rc),
+			"config":         readComponentConfig(p.Config, known, rc["value"]),
+		})
+		diags.Append(d...)
+		values = append(values, obj)
+	}
+
+	list, d := types.ListValue(automationChildObjectType, values)
+	diags.Append(d...)
+	return list
 }
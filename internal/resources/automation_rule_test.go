@@ -0,0 +1,137 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/david/terraform-provider-jira/internal/automation"
+	"github.com/david/terraform-provider-jira/internal/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func mustDecodeRule(t *testing.T, data string) map[string]interface{} {
+	t.Helper()
+	var rule map[string]interface{}
+	if err := json.Unmarshal([]byte(data), &rule); err != nil {
+		t.Fatal(err)
+	}
+	return rule
+}
+
+// A rule_json copied from another rule through the jira_automation_rule data source must
+// not differ from the new rule, which has its own name, state, scope, actor and notifications.
+func TestRemoteRuleJSONCopiedRule(t *testing.T) {
+	golden := mustDecodeRule(t, `{
+		"uuid": "0190d1c4-aaaa-7000-8000-000000000001",
+		"name": "Golden triage",
+		"state": "ENABLED",
+		"ruleScopeARIs": ["ari:cloud:jira:cloud-id:project/10000"],
+		"actor": {"type": "ACCOUNT_ID", "actor": "557058:golden"},
+		"notifyOnError": "FIRSTERROR",
+		"labels": [],
+		"trigger": {"id": "1", "component": "TRIGGER", "type": "jira.issue.event.trigger:created", "schemaVersion": 1, "value": {"eventKey": "jira:issue_created"}},
+		"components": [
+			{"id": "2", "parentId": "1", "component": "ACTION", "type": "jira.issue.edit", "schemaVersion": 10, "value": {"operations": []}, "children": [], "conditions": []}
+		]
+	}`)
+
+	// What the data source reports as rule_json.
+	copied, err := automation.Marshal(automation.StripAttributeFields(automation.StripServerFields(golden)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range automation.AttributeFields {
+		if _, ok := mustDecodeRule(t, copied)[key]; ok {
+			t.Errorf("data source rule_json contains %q", key)
+		}
+	}
+
+	// The copy as JIRA returns it after being created in another project.
+	created := mustDecodeRule(t, `{
+		"uuid": "0190d1c4-bbbb-7000-8000-000000000002",
+		"name": "Triage for EXAMPLE",
+		"state": "ENABLED",
+		"ruleScopeARIs": ["ari:cloud:jira:cloud-id:project/10001"],
+		"actor": {"type": "ACCOUNT_ID", "actor": "557058:terraform"},
+		"notifyOnError": "EVERYERROR",
+		"labels": [],
+		"trigger": {"id": "11", "component": "TRIGGER", "type": "jira.issue.event.trigger:created", "schemaVersion": 1, "value": {"eventKey": "jira:issue_created"}},
+		"components": [
+			{"id": "12", "parentId": "11", "component": "ACTION", "type": "jira.issue.edit", "schemaVersion": 10, "value": {"operations": []}, "children": [], "conditions": []}
+		]
+	}`)
+
+	configured := jsontypes.NewNormalizedValue(copied)
+	refreshed, err := remoteRuleJSON(created, configured)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equal, diags := configured.StringSemanticEquals(context.Background(), refreshed)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !equal {
+		t.Errorf("refreshed rule_json differs from the copied rule:\nconfigured: %s\nrefreshed:  %s", copied, refreshed.ValueString())
+	}
+}
+
+// A hand-written rule_json that still sets the attribute-managed fields keeps them as written.
+func TestRemoteRuleJSONKeepsConfiguredAttributeFields(t *testing.T) {
+	configured := jsontypes.NewNormalizedValue(`{"name":"Written","state":"ENABLED","trigger":{"type":"jira.manual.trigger.issue"}}`)
+	remote := mustDecodeRule(t, `{"name":"Renamed","state":"DISABLED","trigger":{"id":"1","type":"jira.manual.trigger.issue"}}`)
+
+	refreshed, err := remoteRuleJSON(remote, configured)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equal, diags := configured.StringSemanticEquals(context.Background(), refreshed)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !equal {
+		t.Errorf("refreshed rule_json = %s, want %s", refreshed.ValueString(), configured.ValueString())
+	}
+}
+
+// Without a configured scope, ruleScopeARIs in rule_json is sent as written, even when the
+// plan still carries the scope from state.
+func TestBuildRuleBodyKeepsRuleJSONScope(t *testing.T) {
+	r := &AutomationRuleResource{}
+	stale, diags := types.ListValueFrom(context.Background(), types.StringType, []string{"ari:cloud:jira:cloud-id:project/10000"})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	plan := AutomationRuleResourceModel{
+		Name:     types.StringValue("Triage"),
+		RuleJSON: jsontypes.NewNormalizedValue(`{"ruleScopeARIs":["ari:cloud:jira:cloud-id:project/10001"],"trigger":{"type":"jira.manual.trigger.issue"}}`),
+		Scope:    stale,
+	}
+
+	body, diags := r.buildRuleBody(context.Background(), plan, false)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	scope := automation.StringList(body, "ruleScopeARIs")
+	if len(scope) != 1 || scope[0] != "ari:cloud:jira:cloud-id:project/10001" {
+		t.Errorf("ruleScopeARIs = %v, want the value from rule_json", scope)
+	}
+}
+
+// A scope changed in JIRA shows up as a rule_json diff when rule_json sets ruleScopeARIs.
+func TestRemoteRuleJSONComparesRuleScope(t *testing.T) {
+	configured := jsontypes.NewNormalizedValue(`{"ruleScopeARIs":["ari:cloud:jira:cloud-id:project/10000"],"trigger":{"type":"jira.manual.trigger.issue"}}`)
+	remote := mustDecodeRule(t, `{"ruleScopeARIs":["ari:cloud:jira:cloud-id:project/10001"],"trigger":{"id":"1","type":"jira.manual.trigger.issue"}}`)
+
+	refreshed, err := remoteRuleJSON(remote, configured)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equal, diags := configured.StringSemanticEquals(context.Background(), refreshed)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if equal {
+		t.Errorf("refreshed rule_json %s hides the scope change", refreshed.ValueString())
+	}
+}
@@ -0,0 +1,341 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &BoardResource{}
+var _ resource.ResourceWithImportState = &BoardResource{}
+var _ resource.ResourceWithValidateConfig = &BoardResource{}
+
+type BoardResource struct {
+	client *client.Client
+}
+
+type BoardResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Type                 types.String `tfsdk:"type"`
+	ProjectKey           types.String `tfsdk:"project_key"`
+	FilterID             types.String `tfsdk:"filter_id"`
+	Columns              types.List   `tfsdk:"columns"`
+	ColumnConstraintType types.String `tfsdk:"column_constraint_type"`
+	EstimationFieldID    types.String `tfsdk:"estimation_field_id"`
+	EstimationFieldName  types.String `tfsdk:"estimation_field_name"`
+}
+
+var boardColumnObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":       types.StringType,
+		"status_ids": types.ListType{ElemType: types.StringType},
+	},
+}
+
+func NewBoardResource() resource.Resource {
+	return &BoardResource{}
+}
+
+func (r *BoardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_board"
+}
+
+func (r *BoardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The agile API has no board update endpoint, so every configurable attribute forces a new board.
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA Software agile board.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The board ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The board name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The board type: scrum or kanban.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Description: "The key of the project the board is located in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filter_id": schema.StringAttribute{
+				Description: "The ID of the filter that selects the board's issues.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"columns": schema.ListNestedAttribute{
+				Description: "The board columns, in order, with the statuses mapped to each.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The column name.",
+							Computed:    true,
+						},
+						"status_ids": schema.ListAttribute{
+							Description: "IDs of the statuses mapped to the column.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"column_constraint_type": schema.StringAttribute{
+				Description: "The column constraint type (none, issueCount or issueCountExclSubs).",
+				Computed:    true,
+			},
+			"estimation_field_id": schema.StringAttribute{
+				Description: "The ID of the field used for estimation, when the board uses field-based estimation.",
+				Computed:    true,
+			},
+			"estimation_field_name": schema.StringAttribute{
+				Description: "The display name of the field used for estimation.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *BoardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *BoardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BoardResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+	if t := config.Type.ValueString(); t != "scrum" && t != "kanban" {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid board type",
+			fmt.Sprintf("Board type must be scrum or kanban, got %q.", t))
+	}
+}
+
+// readConfiguration fills the computed column and estimation attributes from the board configuration.
+func (r *BoardResource) readConfiguration(ctx context.Context, model *BoardResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var config map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/agile/1.0/board/%s/configuration", model.ID.ValueString()), &config)
+	if err != nil {
+		diags.AddError("Error reading board configuration", err.Error())
+		return diags
+	}
+
+	if filter, ok := config["filter"].(map[string]interface{}); ok {
+		model.FilterID = types.StringValue(fmt.Sprintf("%v", filter["id"]))
+	}
+
+	var columns []attr.Value
+	model.ColumnConstraintType = types.StringNull()
+	if columnConfig, ok := config["columnConfig"].(map[string]interface{}); ok {
+		if ct, ok := columnConfig["constraintType"].(string); ok {
+			model.ColumnConstraintType = types.StringValue(ct)
+		}
+		rawColumns, _ := columnConfig["columns"].([]interface{})
+		for _, raw := range rawColumns {
+			col, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			statusIDs := []string{}
+			rawStatuses, _ := col["statuses"].([]interface{})
+			for _, rs := range rawStatuses {
+				if status, ok := rs.(map[string]interface{}); ok {
+					statusIDs = append(statusIDs, fmt.Sprintf("%v", status["id"]))
+				}
+			}
+			statusList, d := types.ListValueFrom(ctx, types.StringType, statusIDs)
+			diags.Append(d...)
+			obj, d := types.ObjectValue(boardColumnObjectType.AttrTypes, map[string]attr.Value{
+				"name":       types.StringValue(fmt.Sprintf("%v", col["name"])),
+				"status_ids": statusList,
+			})
+			diags.Append(d...)
+			columns = append(columns, obj)
+		}
+	}
+	if columns == nil {
+		columns = []attr.Value{}
+	}
+	listVal, d := types.ListValue(boardColumnObjectType, columns)
+	diags.Append(d...)
+	model.Columns = listVal
+
+	model.EstimationFieldID = types.StringNull()
+	model.EstimationFieldName = types.StringNull()
+	if estimation, ok := config["estimation"].(map[string]interface{}); ok {
+		if field, ok := estimation["field"].(map[string]interface{}); ok {
+			if id, ok := field["fieldId"].(string); ok && id != "" {
+				model.EstimationFieldID = types.StringValue(id)
+			}
+			if name, ok := field["displayName"].(string); ok && name != "" {
+				model.EstimationFieldName = types.StringValue(name)
+			}
+		}
+	}
+
+	return diags
+}
+
+// applyBoard copies the board fields returned by GET /board/{id} onto the model.
+func applyBoard(model *BoardResourceModel, result map[string]interface{}) {
+	model.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	model.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	model.Type = types.StringValue(fmt.Sprintf("%v", result["type"]))
+	if location, ok := result["location"].(map[string]interface{}); ok {
+		if key, ok := location["projectKey"].(string); ok && key != "" {
+			model.ProjectKey = types.StringValue(key)
+		}
+	}
+}
+
+func (r *BoardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BoardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filterID, err := strconv.ParseInt(plan.FilterID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter_id"), "Invalid filter ID",
+			"Filter ID must be a numeric string (e.g. from jira_filter.id).")
+		return
+	}
+
+	body := map[string]interface{}{
+		"name":     plan.Name.ValueString(),
+		"type":     plan.Type.ValueString(),
+		"filterId": filterID,
+		"location": map[string]interface{}{
+			"type":           "project",
+			"projectKeyOrId": plan.ProjectKey.ValueString(),
+		},
+	}
+
+	var result map[string]interface{}
+	err = r.client.Post("/rest/agile/1.0/board", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating board", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	resp.Diagnostics.Append(r.readConfiguration(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *BoardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BoardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/agile/1.0/board/%s", state.ID.ValueString()), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading board", err.Error())
+		return
+	}
+
+	applyBoard(&state, result)
+	resp.Diagnostics.Append(r.readConfiguration(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *BoardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement; nothing to update in place.
+	var plan BoardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *BoardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BoardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(fmt.Sprintf("/rest/agile/1.0/board/%s", state.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting board", err.Error())
+		return
+	}
+}
+
+func (r *BoardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/agile/1.0/board/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing board", err.Error())
+		return
+	}
+
+	var state BoardResourceModel
+	applyBoard(&state, result)
+	resp.Diagnostics.Append(r.readConfiguration(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
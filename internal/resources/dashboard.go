@@ -0,0 +1,234 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &DashboardResource{}
+var _ resource.ResourceWithImportState = &DashboardResource{}
+
+type DashboardResource struct {
+	client *client.Client
+}
+
+type DashboardResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	OwnerAccountID   types.String `tfsdk:"owner_account_id"`
+	SharePermissions types.List   `tfsdk:"share_permissions"`
+	EditPermissions  types.List   `tfsdk:"edit_permissions"`
+}
+
+func NewDashboardResource() resource.Resource {
+	return &DashboardResource{}
+}
+
+func (r *DashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (r *DashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA dashboard.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The dashboard ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The dashboard name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The dashboard description.",
+				Optional:    true,
+			},
+			"owner_account_id": schema.StringAttribute{
+				Description: "The account ID of the dashboard owner.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"share_permissions": sharePermissionsSchema("Who can view the dashboard.", "group, project, projectRole, global, authenticated or user"),
+			"edit_permissions":  sharePermissionsSchema("Who can edit the dashboard.", "group, project, projectRole or user"),
+		},
+	}
+}
+
+func (r *DashboardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *DashboardResource) buildBody(ctx context.Context, plan DashboardResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	// A description removed from config is sent as "", so it is cleared.
+	body["description"] = plan.Description.ValueString()
+
+	// Both permission lists are required by the API, even when empty.
+	share, d := buildSharePermissions(ctx, plan.SharePermissions)
+	diags.Append(d...)
+	edit, d := buildSharePermissions(ctx, plan.EditPermissions)
+	diags.Append(d...)
+	body["sharePermissions"] = share
+	body["editPermissions"] = edit
+
+	return body, diags
+}
+
+func (r *DashboardResource) applyResult(ctx context.Context, state *DashboardResourceModel, result map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	state.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	state.Description = refreshedString(state.Description, result["description"])
+	if owner, ok := result["owner"].(map[string]interface{}); ok {
+		state.OwnerAccountID = types.StringValue(fmt.Sprintf("%v", owner["accountId"]))
+	}
+
+	share, d := sharePermissionsToList(ctx, state.SharePermissions, parseSharePermissions(result["sharePermissions"]))
+	diags.Append(d...)
+	state.SharePermissions = share
+	edit, d := sharePermissionsToList(ctx, state.EditPermissions, parseSharePermissions(result["editPermissions"]))
+	diags.Append(d...)
+	state.EditPermissions = edit
+
+	return diags
+}
+
+func (r *DashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.buildBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post("/rest/api/3/dashboard", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating dashboard", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	if owner, ok := result["owner"].(map[string]interface{}); ok {
+		plan.OwnerAccountID = types.StringValue(fmt.Sprintf("%v", owner["accountId"]))
+	} else {
+		plan.OwnerAccountID = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/dashboard/%s", state.ID.ValueString()), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading dashboard", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyResult(ctx, &state, result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.buildBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/dashboard/%s", plan.ID.ValueString()), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating dashboard", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(fmt.Sprintf("/rest/api/3/dashboard/%s", state.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting dashboard", err.Error())
+		return
+	}
+}
+
+func (r *DashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/dashboard/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing dashboard", err.Error())
+		return
+	}
+
+	state := DashboardResourceModel{
+		SharePermissions: types.ListNull(sharePermissionObjectType),
+		EditPermissions:  types.ListNull(sharePermissionObjectType),
+	}
+	resp.Diagnostics.Append(r.applyResult(ctx, &state, result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,506 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &DashboardGadgetResource{}
+var _ resource.ResourceWithImportState = &DashboardGadgetResource{}
+var _ resource.ResourceWithValidateConfig = &DashboardGadgetResource{}
+
+type DashboardGadgetResource struct {
+	client *client.Client
+}
+
+type DashboardGadgetResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DashboardID types.String `tfsdk:"dashboard_id"`
+	ModuleKey   types.String `tfsdk:"module_key"`
+	URI         types.String `tfsdk:"uri"`
+	Title       types.String `tfsdk:"title"`
+	Color       types.String `tfsdk:"color"`
+	Row         types.Int64  `tfsdk:"row"`
+	Column      types.Int64  `tfsdk:"column"`
+	Properties  types.Map    `tfsdk:"properties"`
+}
+
+func NewDashboardGadgetResource() resource.Resource {
+	return &DashboardGadgetResource{}
+}
+
+func (r *DashboardGadgetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_gadget"
+}
+
+func (r *DashboardGadgetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a gadget on a JIRA dashboard.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The gadget ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard_id": schema.StringAttribute{
+				Description: "The ID of the dashboard the gadget is on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"module_key": schema.StringAttribute{
+				Description: "The module key of the gadget type. Provide either module_key or uri.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				Description: "The URI of the gadget type. Provide either module_key or uri.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The gadget title. Defaults to the title of the gadget type.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"color": schema.StringAttribute{
+				Description: "The gadget color: blue, red, yellow, green, cyan, purple, gray or white.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"row": schema.Int64Attribute{
+				Description: "The row of the gadget on the dashboard, starting at 0. Set together with column.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"column": schema.Int64Attribute{
+				Description: "The column of the gadget on the dashboard, starting at 0. Set together with row.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				Description: "Gadget configuration properties. Each value is a JSON document (use jsonencode). Only the keys listed here are managed.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *DashboardGadgetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *DashboardGadgetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DashboardGadgetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ModuleKey.IsUnknown() && !config.URI.IsUnknown() {
+		hasModuleKey := !config.ModuleKey.IsNull()
+		hasURI := !config.URI.IsNull()
+		if hasModuleKey == hasURI {
+			resp.Diagnostics.AddError("Invalid gadget type", "Exactly one of module_key or uri must be specified.")
+		}
+	}
+
+	if !config.Row.IsUnknown() && !config.Column.IsUnknown() && config.Row.IsNull() != config.Column.IsNull() {
+		resp.Diagnostics.AddError("Incomplete gadget position", "row and column must be specified together.")
+	}
+
+	if !config.Properties.IsNull() && !config.Properties.IsUnknown() {
+		props := make(map[string]types.String)
+		resp.Diagnostics.Append(config.Properties.ElementsAs(ctx, &props, false)...)
+		for key, value := range props {
+			if value.IsNull() || value.IsUnknown() {
+				continue
+			}
+			if !json.Valid([]byte(value.ValueString())) {
+				resp.Diagnostics.AddAttributeError(path.Root("properties").AtMapKey(key), "Invalid property value",
+					"Gadget property values must be JSON documents. Wrap plain values with jsonencode().")
+			}
+		}
+	}
+}
+
+func gadgetPath(dashboardID, gadgetID string) string {
+	return fmt.Sprintf("/rest/api/3/dashboard/%s/gadget/%s", dashboardID, gadgetID)
+}
+
+func gadgetPropertyPath(dashboardID, gadgetID, key string) string {
+	return fmt.Sprintf("/rest/api/3/dashboard/%s/items/%s/properties/%s", dashboardID, gadgetID, url.PathEscape(key))
+}
+
+// fetchGadget returns the gadget, or nil when it no longer exists on the dashboard.
+func (r *DashboardGadgetResource) fetchGadget(dashboardID, gadgetID string) (map[string]interface{}, error) {
+	var result struct {
+		Gadgets []map[string]interface{} `json:"gadgets"`
+	}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/dashboard/%s/gadget?gadgetId=%s", dashboardID, gadgetID), &result)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range result.Gadgets {
+		if fmt.Sprintf("%v", g["id"]) == gadgetID {
+			return g, nil
+		}
+	}
+	return nil, nil
+}
+
+// fetchProperty returns the JSON-encoded value of a gadget property, or "" when it is not set.
+func (r *DashboardGadgetResource) fetchProperty(dashboardID, gadgetID, key string) (string, error) {
+	var result struct {
+		Value json.RawMessage `json:"value"`
+	}
+	err := r.client.Get(gadgetPropertyPath(dashboardID, gadgetID, key), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return string(result.Value), nil
+}
+
+func (r *DashboardGadgetResource) applyGadget(model *DashboardGadgetResourceModel, gadget map[string]interface{}) {
+	model.ID = types.StringValue(fmt.Sprintf("%v", gadget["id"]))
+	if title, ok := gadget["title"].(string); ok {
+		model.Title = types.StringValue(title)
+	}
+	if color, ok := gadget["color"].(string); ok {
+		model.Color = types.StringValue(color)
+	}
+	if position, ok := gadget["position"].(map[string]interface{}); ok {
+		if row, ok := position["row"].(float64); ok {
+			model.Row = types.Int64Value(int64(row))
+		}
+		if column, ok := position["column"].(float64); ok {
+			model.Column = types.Int64Value(int64(column))
+		}
+	}
+}
+
+// clearUnknown nulls computed attributes the API did not return, so state never holds unknowns.
+func (r *DashboardGadgetResource) clearUnknown(model *DashboardGadgetResourceModel) {
+	if model.Title.IsUnknown() {
+		model.Title = types.StringNull()
+	}
+	if model.Color.IsUnknown() {
+		model.Color = types.StringNull()
+	}
+	if model.Row.IsUnknown() {
+		model.Row = types.Int64Null()
+	}
+	if model.Column.IsUnknown() {
+		model.Column = types.Int64Null()
+	}
+}
+
+// readProperties reads back the values of the given keys, keeping the prior string
+// when it is semantically equal to the stored JSON.
+func (r *DashboardGadgetResource) readProperties(ctx context.Context, model *DashboardGadgetResourceModel, keys []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	prior := make(map[string]string)
+	if !model.Properties.IsNull() && !model.Properties.IsUnknown() {
+		diags.Append(model.Properties.ElementsAs(ctx, &prior, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	props := make(map[string]string)
+	for _, key := range keys {
+		value, err := r.fetchProperty(model.DashboardID.ValueString(), model.ID.ValueString(), key)
+		if err != nil {
+			diags.AddError("Error reading gadget property", err.Error())
+			return diags
+		}
+		if value == "" {
+			continue
+		}
+		if p, ok := prior[key]; ok && jsonEqual(p, value) {
+			value = p
+		}
+		props[key] = value
+	}
+
+	if len(props) == 0 && model.Properties.IsNull() {
+		return diags
+	}
+	mapVal, d := types.MapValueFrom(ctx, types.StringType, props)
+	diags.Append(d...)
+	model.Properties = mapVal
+	return diags
+}
+
+// syncProperties sets every planned property and removes the ones dropped since the prior state.
+func (r *DashboardGadgetResource) syncProperties(ctx context.Context, plan DashboardGadgetResourceModel, prior types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	wanted := make(map[string]string)
+	if !plan.Properties.IsNull() && !plan.Properties.IsUnknown() {
+		diags.Append(plan.Properties.ElementsAs(ctx, &wanted, false)...)
+	}
+	current := make(map[string]string)
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &current, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	dashboardID := plan.DashboardID.ValueString()
+	gadgetID := plan.ID.ValueString()
+	for key := range current {
+		if _, ok := wanted[key]; ok {
+			continue
+		}
+		if err := r.client.Delete(gadgetPropertyPath(dashboardID, gadgetID, key)); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error removing gadget property", err.Error())
+			return diags
+		}
+	}
+	for key, value := range wanted {
+		if old, ok := current[key]; ok && jsonEqual(old, value) {
+			continue
+		}
+		if err := r.client.Put(gadgetPropertyPath(dashboardID, gadgetID, key), json.RawMessage(value), nil); err != nil {
+			diags.AddError("Error setting gadget property", err.Error())
+			return diags
+		}
+	}
+	return diags
+}
+
+func (r *DashboardGadgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DashboardGadgetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{}
+	if !plan.ModuleKey.IsNull() && !plan.ModuleKey.IsUnknown() {
+		body["moduleKey"] = plan.ModuleKey.ValueString()
+	}
+	if !plan.URI.IsNull() && !plan.URI.IsUnknown() {
+		body["uri"] = plan.URI.ValueString()
+	}
+	if !plan.Title.IsNull() && !plan.Title.IsUnknown() {
+		body["title"] = plan.Title.ValueString()
+	}
+	if !plan.Color.IsNull() && !plan.Color.IsUnknown() {
+		body["color"] = plan.Color.ValueString()
+	}
+	if !plan.Row.IsUnknown() && !plan.Column.IsUnknown() && !plan.Row.IsNull() && !plan.Column.IsNull() {
+		body["position"] = map[string]interface{}{
+			"row":    plan.Row.ValueInt64(),
+			"column": plan.Column.ValueInt64(),
+		}
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post(fmt.Sprintf("/rest/api/3/dashboard/%s/gadget", plan.DashboardID.ValueString()), body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating dashboard gadget", err.Error())
+		return
+	}
+	// The response carries the assigned title, color and position.
+	r.applyGadget(&plan, result)
+	r.clearUnknown(&plan)
+
+	resp.Diagnostics.Append(r.syncProperties(ctx, plan, types.MapNull(types.StringType))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DashboardGadgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardGadgetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gadget, err := r.fetchGadget(state.DashboardID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading dashboard gadget", err.Error())
+		return
+	}
+	if gadget == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.applyGadget(&state, gadget)
+
+	// Gadgets keep their own settings in properties too; only the managed keys are read back.
+	var keys []string
+	if !state.Properties.IsNull() {
+		props := make(map[string]string)
+		resp.Diagnostics.Append(state.Properties.ElementsAs(ctx, &props, false)...)
+		for key := range props {
+			keys = append(keys, key)
+		}
+	}
+	resp.Diagnostics.Append(r.readProperties(ctx, &state, keys)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *DashboardGadgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DashboardGadgetResourceModel
+	var state DashboardGadgetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{}
+	if !plan.Title.IsNull() && !plan.Title.IsUnknown() {
+		body["title"] = plan.Title.ValueString()
+	}
+	if !plan.Color.IsNull() && !plan.Color.IsUnknown() {
+		body["color"] = plan.Color.ValueString()
+	}
+	if !plan.Row.IsUnknown() && !plan.Column.IsUnknown() && !plan.Row.IsNull() && !plan.Column.IsNull() {
+		body["position"] = map[string]interface{}{
+			"row":    plan.Row.ValueInt64(),
+			"column": plan.Column.ValueInt64(),
+		}
+	}
+
+	err := r.client.Put(gadgetPath(plan.DashboardID.ValueString(), plan.ID.ValueString()), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating dashboard gadget", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncProperties(ctx, plan, state.Properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read back computed attributes that were not configured.
+	if plan.Title.IsUnknown() || plan.Color.IsUnknown() || plan.Row.IsUnknown() || plan.Column.IsUnknown() {
+		gadget, err := r.fetchGadget(plan.DashboardID.ValueString(), plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading dashboard gadget", err.Error())
+			return
+		}
+		if gadget != nil {
+			r.applyGadget(&plan, gadget)
+		}
+		r.clearUnknown(&plan)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DashboardGadgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardGadgetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(gadgetPath(state.DashboardID.ValueString(), state.ID.ValueString()))
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting dashboard gadget", err.Error())
+		return
+	}
+}
+
+func (r *DashboardGadgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by "dashboardId/gadgetId".
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format dashboardId/gadgetId, got %q.", req.ID))
+		return
+	}
+
+	gadget, err := r.fetchGadget(parts[0], parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing dashboard gadget", err.Error())
+		return
+	}
+	if gadget == nil {
+		resp.Diagnostics.AddError("Error importing dashboard gadget",
+			fmt.Sprintf("Gadget %s not found on dashboard %s.", parts[1], parts[0]))
+		return
+	}
+
+	state := DashboardGadgetResourceModel{
+		DashboardID: types.StringValue(parts[0]),
+		ModuleKey:   types.StringNull(),
+		URI:         types.StringNull(),
+		Properties:  types.MapNull(types.StringType),
+	}
+	r.applyGadget(&state, gadget)
+	if key, ok := gadget["moduleKey"].(string); ok && key != "" {
+		state.ModuleKey = types.StringValue(key)
+	} else if uri, ok := gadget["uri"].(string); ok && uri != "" {
+		state.URI = types.StringValue(uri)
+	}
+
+	// Properties are not imported. Gadgets keep their internal configuration in properties
+	// too, and importing every key would make the first apply delete those not configured.
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
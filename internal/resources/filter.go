@@ -0,0 +1,462 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &FilterResource{}
+var _ resource.ResourceWithImportState = &FilterResource{}
+
+type FilterResource struct {
+	client *client.Client
+}
+
+type FilterResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	JQL              types.String `tfsdk:"jql"`
+	Favourite        types.Bool   `tfsdk:"favourite"`
+	OwnerAccountID   types.String `tfsdk:"owner_account_id"`
+	SharePermissions types.List   `tfsdk:"share_permissions"`
+	EditPermissions  types.List   `tfsdk:"edit_permissions"`
+}
+
+var sharePermissionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":            types.StringType,
+		"group_name":      types.StringType,
+		"project_id":      types.StringType,
+		"project_role_id": types.StringType,
+		"account_id":      types.StringType,
+	},
+}
+
+// sharePermission is a single share or edit permission of a filter or dashboard.
+// Which of the optional fields is set depends on Type.
+type sharePermission struct {
+	Type          string       `tfsdk:"type"`
+	GroupName     types.String `tfsdk:"group_name"`
+	ProjectID     types.String `tfsdk:"project_id"`
+	ProjectRoleID types.String `tfsdk:"project_role_id"`
+	AccountID     types.String `tfsdk:"account_id"`
+}
+
+// sharePermissionsSchema returns the nested list attribute used for share_permissions and edit_permissions.
+func sharePermissionsSchema(description, validTypes string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "The permission type: " + validTypes + ".",
+					Required:    true,
+				},
+				"group_name": schema.StringAttribute{
+					Description: "The group name. Required when type is group.",
+					Optional:    true,
+				},
+				"project_id": schema.StringAttribute{
+					Description: "The project ID. Required when type is project or projectRole.",
+					Optional:    true,
+				},
+				"project_role_id": schema.StringAttribute{
+					Description: "The project role ID. Required when type is projectRole.",
+					Optional:    true,
+				},
+				"account_id": schema.StringAttribute{
+					Description: "The Atlassian account ID. Required when type is user.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func NewFilterResource() resource.Resource {
+	return &FilterResource{}
+}
+
+func (r *FilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_filter"
+}
+
+func (r *FilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA saved filter.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The filter ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The filter name. Must be unique per owner.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The filter description.",
+				Optional:    true,
+			},
+			"jql": schema.StringAttribute{
+				Description: "The JQL query of the filter.",
+				Required:    true,
+			},
+			"favourite": schema.BoolAttribute{
+				Description: "Whether the filter is a favourite of the user the provider authenticates as. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"owner_account_id": schema.StringAttribute{
+				Description: "The account ID of the filter owner.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"share_permissions": sharePermissionsSchema("Who can view the filter.", "group, project, projectRole, global, authenticated or user"),
+			"edit_permissions":  sharePermissionsSchema("Who can edit the filter.", "group, project, projectRole or user"),
+		},
+	}
+}
+
+func (r *FilterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+// buildSharePermissions converts configured permissions into the SharePermission API shape.
+func buildSharePermissions(ctx context.Context, list types.List) ([]map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := []map[string]interface{}{}
+	if list.IsNull() || list.IsUnknown() {
+		return result, diags
+	}
+
+	var entries []sharePermission
+	diags.Append(list.ElementsAs(ctx, &entries, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, e := range entries {
+		perm := map[string]interface{}{"type": e.Type}
+		if v := e.GroupName.ValueString(); v != "" {
+			perm["group"] = map[string]interface{}{"name": v}
+		}
+		if v := e.ProjectID.ValueString(); v != "" {
+			perm["project"] = map[string]interface{}{"id": v}
+		}
+		if v := e.ProjectRoleID.ValueString(); v != "" {
+			perm["role"] = map[string]interface{}{"id": v}
+		}
+		if v := e.AccountID.ValueString(); v != "" {
+			perm["user"] = map[string]interface{}{"accountId": v}
+		}
+		result = append(result, perm)
+	}
+	return result, diags
+}
+
+// parseSharePermissions converts the API SharePermission objects into entries.
+func parseSharePermissions(raw interface{}) []sharePermission {
+	items, _ := raw.([]interface{})
+	var entries []sharePermission
+	for _, item := range items {
+		perm, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		entry := sharePermission{
+			Type:          fmt.Sprintf("%v", perm["type"]),
+			GroupName:     types.StringNull(),
+			ProjectID:     types.StringNull(),
+			ProjectRoleID: types.StringNull(),
+			AccountID:     types.StringNull(),
+		}
+		if group, ok := perm["group"].(map[string]interface{}); ok {
+			if name, ok := group["name"].(string); ok {
+				entry.GroupName = optionalString(name)
+			}
+		}
+		if project, ok := perm["project"].(map[string]interface{}); ok {
+			entry.ProjectID = optionalString(fmt.Sprintf("%v", project["id"]))
+		}
+		if role, ok := perm["role"].(map[string]interface{}); ok {
+			entry.ProjectRoleID = optionalString(fmt.Sprintf("%v", role["id"]))
+		}
+		if user, ok := perm["user"].(map[string]interface{}); ok {
+			if accountID, ok := user["accountId"].(string); ok {
+				entry.AccountID = optionalString(accountID)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func sharePermissionKey(p sharePermission) string {
+	return strings.ToLower(p.Type) + "|" + p.GroupName.ValueString() + "|" + p.ProjectID.ValueString() + "|" +
+		p.ProjectRoleID.ValueString() + "|" + p.AccountID.ValueString()
+}
+
+// sharePermissionsToList converts remote permissions into the Terraform list, keeping the order
+// of the prior value for entries that still exist.
+func sharePermissionsToList(ctx context.Context, prior types.List, remote []sharePermission) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var priorEntries []sharePermission
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorEntries, false)...)
+		if diags.HasError() {
+			return types.ListNull(sharePermissionObjectType), diags
+		}
+	}
+
+	var ordered []sharePermission
+	used := make([]bool, len(remote))
+	for _, p := range priorEntries {
+		for i, e := range remote {
+			if !used[i] && sharePermissionKey(e) == sharePermissionKey(p) {
+				// Echo the configured casing of the type.
+				e.Type = p.Type
+				ordered = append(ordered, e)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, e := range remote {
+		if !used[i] {
+			ordered = append(ordered, e)
+		}
+	}
+
+	if len(ordered) == 0 {
+		if prior.IsNull() {
+			return types.ListNull(sharePermissionObjectType), diags
+		}
+		return types.ListValueMust(sharePermissionObjectType, []attr.Value{}), diags
+	}
+
+	var values []attr.Value
+	for _, e := range ordered {
+		obj, d := types.ObjectValue(sharePermissionObjectType.AttrTypes, map[string]attr.Value{
+			"type":            types.StringValue(e.Type),
+			"group_name":      e.GroupName,
+			"project_id":      e.ProjectID,
+			"project_role_id": e.ProjectRoleID,
+			"account_id":      e.AccountID,
+		})
+		diags.Append(d...)
+		values = append(values, obj)
+	}
+	listVal, d := types.ListValue(sharePermissionObjectType, values)
+	diags.Append(d...)
+	return listVal, diags
+}
+
+func (r *FilterResource) buildBody(ctx context.Context, plan FilterResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+		"jql":  plan.JQL.ValueString(),
+	}
+	// A description removed from config is sent as "", so it is cleared.
+	body["description"] = plan.Description.ValueString()
+
+	share, d := buildSharePermissions(ctx, plan.SharePermissions)
+	diags.Append(d...)
+	edit, d := buildSharePermissions(ctx, plan.EditPermissions)
+	diags.Append(d...)
+	body["sharePermissions"] = share
+	body["editPermissions"] = edit
+
+	return body, diags
+}
+
+// setFavourite adds the filter to or removes it from the current user's favourites.
+func (r *FilterResource) setFavourite(filterID string, favourite bool) error {
+	if favourite {
+		return r.client.Put(fmt.Sprintf("/rest/api/3/filter/%s/favourite", filterID), nil, nil)
+	}
+	return r.client.Delete(fmt.Sprintf("/rest/api/3/filter/%s/favourite", filterID))
+}
+
+func (r *FilterResource) applyResult(ctx context.Context, state *FilterResourceModel, result map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	state.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	state.Description = refreshedString(state.Description, result["description"])
+	if jql, ok := result["jql"].(string); ok {
+		state.JQL = types.StringValue(jql)
+	}
+	favourite, _ := result["favourite"].(bool)
+	state.Favourite = types.BoolValue(favourite)
+	if owner, ok := result["owner"].(map[string]interface{}); ok {
+		state.OwnerAccountID = types.StringValue(fmt.Sprintf("%v", owner["accountId"]))
+	}
+
+	share, d := sharePermissionsToList(ctx, state.SharePermissions, parseSharePermissions(result["sharePermissions"]))
+	diags.Append(d...)
+	state.SharePermissions = share
+	edit, d := sharePermissionsToList(ctx, state.EditPermissions, parseSharePermissions(result["editPermissions"]))
+	diags.Append(d...)
+	state.EditPermissions = edit
+
+	return diags
+}
+
+func (r *FilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FilterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.buildBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post("/rest/api/3/filter", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating filter", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	if owner, ok := result["owner"].(map[string]interface{}); ok {
+		plan.OwnerAccountID = types.StringValue(fmt.Sprintf("%v", owner["accountId"]))
+	} else {
+		plan.OwnerAccountID = types.StringNull()
+	}
+
+	// New filters are not favourites unless requested; only call the endpoint when it matters.
+	if favourite, _ := result["favourite"].(bool); favourite != plan.Favourite.ValueBool() {
+		if err := r.setFavourite(plan.ID.ValueString(), plan.Favourite.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Error setting filter favourite", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *FilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FilterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/filter/%s", state.ID.ValueString()), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading filter", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyResult(ctx, &state, result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *FilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan FilterResourceModel
+	var state FilterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.buildBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// PUT replaces the share and edit permissions with the ones in the body.
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/filter/%s", plan.ID.ValueString()), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating filter", err.Error())
+		return
+	}
+
+	if !plan.Favourite.Equal(state.Favourite) {
+		if err := r.setFavourite(plan.ID.ValueString(), plan.Favourite.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Error setting filter favourite", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *FilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FilterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(fmt.Sprintf("/rest/api/3/filter/%s", state.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting filter", err.Error())
+		return
+	}
+}
+
+func (r *FilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/filter/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing filter", err.Error())
+		return
+	}
+
+	state := FilterResourceModel{
+		SharePermissions: types.ListNull(sharePermissionObjectType),
+		EditPermissions:  types.ListNull(sharePermissionObjectType),
+	}
+	resp.Diagnostics.Append(r.applyResult(ctx, &state, result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
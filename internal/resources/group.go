@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
 
 	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,6 +17,10 @@ import (
 
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithModifyPlan = &GroupResource{}
+
+// groupIDPattern matches the UUID group IDs of JIRA Cloud.
+var groupIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
 
 type GroupResource struct {
 	client *client.Client
@@ -35,7 +41,7 @@ func (r *GroupResource) Metadata(_ context.Context, req resource.MetadataRequest
 
 func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a JIRA user group.",
+		Description: "Manages a JIRA user group. Groups are tracked by group ID. JIRA cannot rename groups, so changing the name replaces the group.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The group ID.",
@@ -45,8 +51,11 @@ func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The group name.",
+				Description: "The group name. Changing it deletes the group and creates a new one, which drops its members and every grant that refers to it.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
@@ -64,6 +73,55 @@ func (r *GroupResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.client = c
 }
 
+// ModifyPlan warns when a rename replaces the group, since the new group starts without
+// members and without the permissions granted to the old one.
+func (r *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state GroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.Name.Equal(state.Name) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(path.Root("name"), "Group will be replaced",
+		fmt.Sprintf("JIRA cannot rename groups. Changing the name from %q to %q deletes group %s and creates a new group. "+
+			"All members of the group, and all permission scheme grants, project roles and filters that refer to it, are lost. "+
+			"To keep them, rename the group in Atlassian administration and update name in the configuration to match.",
+			state.Name.ValueString(), plan.Name.ValueString(), state.ID.ValueString()))
+}
+
+// lookupGroup finds a group through /group/bulk by groupId or groupName and returns nil
+// when no group matches.
+func lookupGroup(c *client.Client, params url.Values) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.Get("/rest/api/3/group/bulk?"+params.Encode(), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	values, ok := result["values"].([]interface{})
+	if !ok || len(values) == 0 {
+		return nil, nil
+	}
+	group, _ := values[0].(map[string]interface{})
+	return group, nil
+}
+
+// groupLookupParams looks a group up by ID, falling back to the name for state written
+// before groups were tracked by ID, when the name was stored as the ID.
+func groupLookupParams(id, name string) url.Values {
+	if groupIDPattern.MatchString(id) {
+		return url.Values{"groupId": {id}}
+	}
+	return url.Values{"groupName": {name}}
+}
+
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan GroupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -82,12 +140,12 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	if groupId, ok := result["groupId"].(string); ok {
-		plan.ID = types.StringValue(groupId)
-	} else {
-		// Fallback: use name as ID
-		plan.ID = types.StringValue(plan.Name.ValueString())
+	groupId, ok := result["groupId"].(string)
+	if !ok || groupId == "" {
+		resp.Diagnostics.AddError("Error creating group", "JIRA did not return a group ID for the new group.")
+		return
 	}
+	plan.ID = types.StringValue(groupId)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -99,65 +157,31 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Use bulk get to find the group
-	var result map[string]interface{}
-	params := url.Values{"groupName": {state.Name.ValueString()}}
-	err := r.client.Get("/rest/api/3/group/bulk?"+params.Encode(), &result)
+	group, err := lookupGroup(r.client, groupLookupParams(state.ID.ValueString(), state.Name.ValueString()))
 	if err != nil {
-		if client.IsNotFound(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("Error reading group", err.Error())
 		return
 	}
-
-	if values, ok := result["values"].([]interface{}); ok && len(values) > 0 {
-		group := values[0].(map[string]interface{})
-		state.Name = types.StringValue(fmt.Sprintf("%v", group["name"]))
-		if groupId, ok := group["groupId"].(string); ok {
-			state.ID = types.StringValue(groupId)
-		}
-	} else {
+	if group == nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
+	state.Name = types.StringValue(fmt.Sprintf("%v", group["name"]))
+	if groupId, ok := group["groupId"].(string); ok && groupId != "" {
+		state.ID = types.StringValue(groupId)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// name requires replacement, so there is nothing to update in place.
 	var plan GroupResourceModel
-	var state GroupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	// JIRA doesn't support renaming groups directly.
-	// We need to delete the old group and create a new one.
-	params := url.Values{"groupname": {state.Name.ValueString()}}
-	err := r.client.DeleteWithQuery("/rest/api/3/group", params)
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting old group during rename", err.Error())
-		return
-	}
-
-	body := map[string]interface{}{
-		"name": plan.Name.ValueString(),
-	}
-	var result map[string]interface{}
-	err = r.client.Post("/rest/api/3/group", body, &result)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating new group during rename", err.Error())
-		return
-	}
-
-	if groupId, ok := result["groupId"].(string); ok {
-		plan.ID = types.StringValue(groupId)
-	}
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -169,35 +193,40 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	params := url.Values{"groupname": {state.Name.ValueString()}}
+	if groupIDPattern.MatchString(state.ID.ValueString()) {
+		params = url.Values{"groupId": {state.ID.ValueString()}}
+	}
 	err := r.client.DeleteWithQuery("/rest/api/3/group", params)
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Error deleting group", err.Error())
 		return
 	}
 }
 
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by group name
-	var result map[string]interface{}
+	// Import by group ID or group name.
 	params := url.Values{"groupName": {req.ID}}
-	err := r.client.Get("/rest/api/3/group/bulk?"+params.Encode(), &result)
+	if groupIDPattern.MatchString(req.ID) {
+		params = url.Values{"groupId": {req.ID}}
+	}
+	group, err := lookupGroup(r.client, params)
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing group", err.Error())
 		return
 	}
+	if group == nil {
+		resp.Diagnostics.AddError("Group not found", fmt.Sprintf("No group with ID or name %s found.", req.ID))
+		return
+	}
 
-	if values, ok := result["values"].([]interface{}); ok && len(values) > 0 {
-		group := values[0].(map[string]interface{})
-		state := GroupResourceModel{
-			Name: types.StringValue(fmt.Sprintf("%v", group["name"])),
-		}
-		if groupId, ok := group["groupId"].(string); ok {
-			state.ID = types.StringValue(groupId)
-		} else {
-			state.ID = types.StringValue(req.ID)
-		}
-		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
-	} else {
-		resp.Diagnostics.AddError("Group not found", fmt.Sprintf("No group with name %s found.", req.ID))
+	groupId, ok := group["groupId"].(string)
+	if !ok || groupId == "" {
+		resp.Diagnostics.AddError("Error importing group", fmt.Sprintf("JIRA did not return a group ID for group %s.", req.ID))
+		return
+	}
+	state := GroupResourceModel{
+		ID:   types.StringValue(groupId),
+		Name: types.StringValue(fmt.Sprintf("%v", group["name"])),
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
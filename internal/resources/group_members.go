@@ -0,0 +1,268 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &GroupMembersResource{}
+var _ resource.ResourceWithImportState = &GroupMembersResource{}
+
+type GroupMembersResource struct {
+	client *client.Client
+}
+
+type GroupMembersResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	GroupID        types.String `tfsdk:"group_id"`
+	Members        types.Set    `tfsdk:"members"`
+	IgnoreInactive types.Bool   `tfsdk:"ignore_inactive"`
+}
+
+func NewGroupMembersResource() resource.Resource {
+	return &GroupMembersResource{}
+}
+
+func (r *GroupMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members"
+}
+
+func (r *GroupMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete member list of a JIRA group. Members that are not listed are removed from the group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The group ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetAttribute{
+				Description: "Account IDs of the group members. An empty set removes all members.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"ignore_inactive": schema.BoolAttribute{
+				Description: "Leave inactive users that are not listed in members in the group instead of removing them. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *GroupMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *GroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GroupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.GroupID
+	diags := r.syncMembers(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		// Some members could not be changed. Record the members JIRA has now, so the state
+		// is saved (and tainted on create) and the next plan retries the missing changes.
+		if err := r.readMembers(ctx, &plan, &resp.Diagnostics); err != nil {
+			resp.Diagnostics.AddError("Error reading group members", err.Error())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *GroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GroupMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.IgnoreInactive.IsNull() {
+		state.IgnoreInactive = types.BoolValue(false)
+	}
+	if err := r.readMembers(ctx, &state, &resp.Diagnostics); err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading group members", err.Error())
+		return
+	}
+	state.ID = state.GroupID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// readMembers sets model.Members to the members of the group in JIRA. With ignore_inactive,
+// inactive users only count as members when they are in model.Members, so users deactivated
+// in the organization do not show up as drift.
+func (r *GroupMembersResource) readMembers(ctx context.Context, model *GroupMembersResourceModel, diags *diag.Diagnostics) error {
+	remote, err := r.client.GroupMembers(url.Values{"groupId": {model.GroupID.ValueString()}}, true)
+	if err != nil {
+		return err
+	}
+
+	var prior []string
+	if !model.Members.IsNull() && !model.Members.IsUnknown() {
+		diags.Append(model.Members.ElementsAs(ctx, &prior, false)...)
+	}
+
+	members := []string{}
+	for _, m := range remote {
+		if m.Active || !model.IgnoreInactive.ValueBool() || containsString(prior, m.AccountID) {
+			members = append(members, m.AccountID)
+		}
+	}
+	sort.Strings(members)
+
+	setVal, d := types.SetValueFrom(ctx, types.StringType, members)
+	diags.Append(d...)
+	model.Members = setVal
+	return nil
+}
+
+func (r *GroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GroupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.GroupID
+	diags := r.syncMembers(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		// Some members could not be changed. Record the members JIRA has now, so the state
+		// is saved (and tainted on create) and the next plan retries the missing changes.
+		if err := r.readMembers(ctx, &plan, &resp.Diagnostics); err != nil {
+			resp.Diagnostics.AddError("Error reading group members", err.Error())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// syncMembers adds the planned members that are missing from the group and removes the
+// members that are not planned. The current members are read from JIRA rather than from
+// state, so members added or removed outside Terraform are corrected too.
+func (r *GroupMembersResource) syncMembers(ctx context.Context, plan GroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var wanted []string
+	diags.Append(plan.Members.ElementsAs(ctx, &wanted, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	group := url.Values{"groupId": {plan.GroupID.ValueString()}}
+	current, err := r.client.GroupMembers(group, true)
+	if err != nil {
+		diags.AddError("Error reading group members", err.Error())
+		return diags
+	}
+
+	currentIDs := make([]string, 0, len(current))
+	for _, m := range current {
+		currentIDs = append(currentIDs, m.AccountID)
+		if containsString(wanted, m.AccountID) || (!m.Active && plan.IgnoreInactive.ValueBool()) {
+			continue
+		}
+		if err := r.client.RemoveGroupMember(group, m.AccountID); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error removing user from group", fmt.Sprintf("Could not remove %s from group %s: %s", m.AccountID, plan.GroupID.ValueString(), err))
+		}
+	}
+
+	for _, accountID := range wanted {
+		if containsString(currentIDs, accountID) {
+			continue
+		}
+		if err := r.client.AddGroupMember(group, accountID); err != nil {
+			diags.AddError("Error adding user to group", fmt.Sprintf("Could not add %s to group %s: %s", accountID, plan.GroupID.ValueString(), err))
+		}
+	}
+
+	return diags
+}
+
+func (r *GroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GroupMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the members managed by this resource are removed. Inactive users ignored through
+	// ignore_inactive stay in the group.
+	var members []string
+	resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group := url.Values{"groupId": {state.GroupID.ValueString()}}
+	for _, accountID := range members {
+		if err := r.client.RemoveGroupMember(group, accountID); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Error removing user from group", fmt.Sprintf("Could not remove %s from group %s: %s", accountID, state.GroupID.ValueString(), err))
+		}
+	}
+}
+
+func (r *GroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by group ID or group name.
+	params := url.Values{"groupName": {req.ID}}
+	if groupIDPattern.MatchString(req.ID) {
+		params = url.Values{"groupId": {req.ID}}
+	}
+	group, err := lookupGroup(r.client, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing group members", err.Error())
+		return
+	}
+	groupId, _ := group["groupId"].(string)
+	if groupId == "" {
+		resp.Diagnostics.AddError("Group not found", fmt.Sprintf("No group with ID or name %s found.", req.ID))
+		return
+	}
+
+	state := GroupMembersResourceModel{
+		ID:             types.StringValue(groupId),
+		GroupID:        types.StringValue(groupId),
+		Members:        types.SetNull(types.StringType),
+		IgnoreInactive: types.BoolValue(false),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
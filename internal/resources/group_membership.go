@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -14,15 +16,20 @@ import (
 )
 
 var _ resource.Resource = &GroupMembershipResource{}
+var _ resource.ResourceWithImportState = &GroupMembershipResource{}
+var _ resource.ResourceWithValidateConfig = &GroupMembershipResource{}
+var _ resource.ResourceWithModifyPlan = &GroupMembershipResource{}
 
 type GroupMembershipResource struct {
 	client *client.Client
 }
 
 type GroupMembershipResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	GroupName types.String `tfsdk:"group_name"`
-	AccountID types.String `tfsdk:"account_id"`
+	ID           types.String `tfsdk:"id"`
+	GroupName    types.String `tfsdk:"group_name"`
+	GroupID      types.String `tfsdk:"group_id"`
+	AccountID    types.String `tfsdk:"account_id"`
+	EmailAddress types.String `tfsdk:"email_address"`
 }
 
 func NewGroupMembershipResource() resource.Resource {
@@ -45,19 +52,37 @@ func (r *GroupMembershipResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"group_name": schema.StringAttribute{
-				Description: "The group name.",
-				Required:    true,
+				Description: "The group name. Provide either group_name or group_id.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The group ID. Provide either group_name or group_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"account_id": schema.StringAttribute{
-				Description: "The Atlassian account ID of the user.",
-				Required:    true,
+				Description: "The Atlassian account ID of the user. Provide either account_id or email_address.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"email_address": schema.StringAttribute{
+				Description: "The email address of the user, resolved to an account ID when the membership is created. Provide either account_id or email_address. " +
+					"Changing it replaces the membership only when it resolves to a different user.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -74,6 +99,105 @@ func (r *GroupMembershipResource) Configure(_ context.Context, req resource.Conf
 	r.client = c
 }
 
+func (r *GroupMembershipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.GroupName.IsUnknown() && !config.GroupID.IsUnknown() && config.GroupName.IsNull() == config.GroupID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("group_name"), "Invalid group",
+			"Exactly one of group_name or group_id must be specified.")
+	}
+	if !config.AccountID.IsUnknown() && !config.EmailAddress.IsUnknown() && config.AccountID.IsNull() == config.EmailAddress.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("account_id"), "Invalid user",
+			"Exactly one of account_id or email_address must be specified.")
+	}
+}
+
+// ModifyPlan replaces the membership when email_address points to a different user than the
+// one in state, and warns when the configured group name no longer exists. Comparing the
+// resolved account ID rather than the email address itself keeps imported memberships, which
+// have no email address in state, from being replaced.
+func (r *GroupMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state GroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.EmailAddress.IsNull() && !plan.EmailAddress.IsUnknown() && !plan.EmailAddress.Equal(state.EmailAddress) {
+		accountID, err := r.resolveAccountID(plan.EmailAddress.ValueString())
+		if err != nil || accountID != state.AccountID.ValueString() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("email_address"))
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("account_id"), types.StringUnknown())...)
+		}
+	}
+
+	if !plan.GroupName.IsNull() && !plan.GroupName.IsUnknown() && !plan.GroupName.Equal(state.GroupName) {
+		group, err := lookupGroup(r.client, url.Values{"groupName": {plan.GroupName.ValueString()}})
+		if err == nil && group == nil {
+			resp.Diagnostics.AddAttributeWarning(path.Root("group_name"), "Group not found",
+				fmt.Sprintf("No group %q exists, so replacing the membership will fail during apply. "+
+					"The group of this membership is now named %q; if it was renamed, update group_name to match.",
+					plan.GroupName.ValueString(), state.GroupName.ValueString()))
+		}
+	}
+}
+
+// membershipGroup selects the group of a membership by ID when it is known, so memberships
+// keep working after the group is renamed.
+func membershipGroup(m GroupMembershipResourceModel) url.Values {
+	if id := m.GroupID.ValueString(); id != "" {
+		return url.Values{"groupId": {id}}
+	}
+	return url.Values{"groupname": {m.GroupName.ValueString()}}
+}
+
+// resolveGroup looks up the group of a membership and fills in its ID and current name.
+// It returns false when the group does not exist.
+func (r *GroupMembershipResource) resolveGroup(m *GroupMembershipResourceModel) (bool, error) {
+	params := url.Values{"groupName": {m.GroupName.ValueString()}}
+	if id := m.GroupID.ValueString(); id != "" {
+		params = url.Values{"groupId": {id}}
+	}
+	group, err := lookupGroup(r.client, params)
+	if err != nil || group == nil {
+		return false, err
+	}
+	m.GroupName = types.StringValue(fmt.Sprintf("%v", group["name"]))
+	if groupId, ok := group["groupId"].(string); ok && groupId != "" {
+		m.GroupID = types.StringValue(groupId)
+	}
+	return true, nil
+}
+
+// resolveAccountID looks up the account ID of the user with the given email address.
+func (r *GroupMembershipResource) resolveAccountID(email string) (string, error) {
+	users, err := r.client.FindUsersByEmail(email)
+	if err != nil {
+		return "", err
+	}
+	switch len(users) {
+	case 0:
+		return "", fmt.Errorf("no user found with email '%s'", email)
+	case 1:
+		return fmt.Sprintf("%v", users[0]["accountId"]), nil
+	default:
+		ids := make([]string, 0, len(users))
+		for _, u := range users {
+			ids = append(ids, fmt.Sprintf("%v", u["accountId"]))
+		}
+		return "", fmt.Errorf("%d users match email '%s' (%s); use account_id instead", len(users), email, strings.Join(ids, ", "))
+	}
+}
+
 func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan GroupMembershipResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -81,12 +205,36 @@ func (r *GroupMembershipResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	body := map[string]interface{}{
-		"accountId": plan.AccountID.ValueString(),
+	if plan.AccountID.IsNull() || plan.AccountID.IsUnknown() {
+		accountID, err := r.resolveAccountID(plan.EmailAddress.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("email_address"), "Error resolving user", err.Error())
+			return
+		}
+		plan.AccountID = types.StringValue(accountID)
 	}
 
-	params := url.Values{"groupname": {plan.GroupName.ValueString()}}
-	err := r.client.Post("/rest/api/3/group/user?"+params.Encode(), body, nil)
+	if plan.GroupID.IsUnknown() {
+		plan.GroupID = types.StringNull()
+	}
+	if plan.GroupName.IsUnknown() {
+		plan.GroupName = types.StringNull()
+	}
+	found, err := r.resolveGroup(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading group", err.Error())
+		return
+	}
+	if !found {
+		group := plan.GroupName.ValueString()
+		if group == "" {
+			group = plan.GroupID.ValueString()
+		}
+		resp.Diagnostics.AddError("Group not found", fmt.Sprintf("No group %s found.", group))
+		return
+	}
+
+	err = r.client.AddGroupMember(membershipGroup(plan), plan.AccountID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error adding user to group", err.Error())
 		return
@@ -103,10 +251,18 @@ func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	// Check if user is in the group by listing group members
-	params := url.Values{"groupname": {state.GroupName.ValueString()}}
-	var result map[string]interface{}
-	err := r.client.Get("/rest/api/3/group/member?"+params.Encode(), &result)
+	// Refresh the group name, which changes when the group is renamed.
+	found, err := r.resolveGroup(&state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading group membership", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	members, err := r.client.GroupMembers(membershipGroup(state), true)
 	if err != nil {
 		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -116,30 +272,32 @@ func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	found := false
-	if values, ok := result["values"].([]interface{}); ok {
-		for _, v := range values {
-			member := v.(map[string]interface{})
-			if fmt.Sprintf("%v", member["accountId"]) == state.AccountID.ValueString() {
-				found = true
-				break
-			}
+	found = false
+	for _, m := range members {
+		if m.AccountID == state.AccountID.ValueString() {
+			found = true
+			break
 		}
 	}
-
 	if !found {
-		// Check if there are more pages
-		// For simplicity, if the user isn't in the first page, assume removed
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", state.GroupName.ValueString(), state.AccountID.ValueString()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *GroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Both fields are ForceNew, so Update should never be called.
-	resp.Diagnostics.AddError("Unexpected update", "Group membership does not support in-place updates. Both group_name and account_id require replacement.")
+	// Configured changes require replacement. Updates only carry refreshed computed values,
+	// such as the new group name after a rename.
+	var plan GroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.GroupName.ValueString(), plan.AccountID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -149,13 +307,28 @@ func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	params := url.Values{
-		"groupname": {state.GroupName.ValueString()},
-		"accountId": {state.AccountID.ValueString()},
-	}
-	err := r.client.DeleteWithQuery("/rest/api/3/group/user", params)
-	if err != nil {
+	err := r.client.RemoveGroupMember(membershipGroup(state), state.AccountID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Error removing user from group", err.Error())
 		return
 	}
 }
+
+func (r *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by "group/accountId", where group is the group ID or the group name. Group names
+	// may contain slashes, so the account ID is taken after the last one.
+	i := strings.LastIndex(req.ID, "/")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format group/accountId, got %q.", req.ID))
+		return
+	}
+	group, accountID := req.ID[:i], req.ID[i+1:]
+
+	if groupIDPattern.MatchString(group) {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), group)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_name"), group)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), accountID)...)
+}
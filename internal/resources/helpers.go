@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// isNumeric reports whether s is a non-empty string of decimal digits, as used by JIRA for IDs.
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
+
+// optionalString returns a null string for empty API values so optional attributes stay unset.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// refreshedString returns the value of an optional string attribute as read from JIRA. A
+// missing or empty value is null, so values removed outside Terraform show up as changes,
+// unless prior is "": JIRA stores an empty value as no value.
+func refreshedString(prior types.String, value interface{}) types.String {
+	s, _ := value.(string)
+	if s == "" && !prior.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" {
+		return prior
+	}
+	return optionalString(s)
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal,
+// ignoring key order and insignificant whitespace.
+func jsonEqual(a, b string) bool {
+	var va, vb interface{}
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// didYouMean returns a " Did you mean X?" hint naming the candidate closest to value, or ""
+// when no candidate is close enough to be a likely typo.
+func didYouMean(value string, candidates []string) string {
+	best, bestDistance := "", -1
+	for _, c := range candidates {
+		d := editDistance(strings.ToLower(value), strings.ToLower(c))
+		if bestDistance < 0 || d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+	maxDistance := len(value) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	if best == "" || bestDistance > maxDistance {
+		return ""
+	}
+	return " Did you mean " + best + "?"
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
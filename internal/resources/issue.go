@@ -0,0 +1,757 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/adf"
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &IssueResource{}
+var _ resource.ResourceWithImportState = &IssueResource{}
+var _ resource.ResourceWithValidateConfig = &IssueResource{}
+
+type IssueResource struct {
+	client *client.Client
+}
+
+type IssueResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Key               types.String `tfsdk:"key"`
+	ProjectKey        types.String `tfsdk:"project_key"`
+	IssueType         types.String `tfsdk:"issue_type"`
+	Summary           types.String `tfsdk:"summary"`
+	Description       types.String `tfsdk:"description"`
+	Labels            types.Set    `tfsdk:"labels"`
+	ComponentIDs      types.Set    `tfsdk:"component_ids"`
+	FixVersionIDs     types.Set    `tfsdk:"fix_version_ids"`
+	ParentKey         types.String `tfsdk:"parent_key"`
+	AssigneeAccountID types.String `tfsdk:"assignee_account_id"`
+	Fields            types.String `tfsdk:"fields"`
+	Status            types.String `tfsdk:"status"`
+	Links             types.List   `tfsdk:"links"`
+}
+
+var issueLinkObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":      types.StringType,
+		"direction": types.StringType,
+		"issue_key": types.StringType,
+	},
+}
+
+// issueLinkEntry is a link as configured in Terraform, seen from the managed issue.
+type issueLinkEntry struct {
+	Type      string `tfsdk:"type"`
+	Direction string `tfsdk:"direction"`
+	IssueKey  string `tfsdk:"issue_key"`
+}
+
+// remoteIssueLink is a link as returned in the issuelinks field.
+type remoteIssueLink struct {
+	ID string
+	issueLinkEntry
+}
+
+func issueLinkKey(l issueLinkEntry) string {
+	return strings.ToLower(l.Type) + "|" + strings.ToLower(l.Direction) + "|" + strings.ToUpper(l.IssueKey)
+}
+
+func NewIssueResource() resource.Resource {
+	return &IssueResource{}
+}
+
+func (r *IssueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue"
+}
+
+func (r *IssueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA issue. Intended for bootstrap tickets such as runbooks, epics and onboarding tasks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The issue ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The issue key (e.g. PROJ-42).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Description: "The key of the project the issue is created in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_type": schema.StringAttribute{
+				Description: "The issue type, by ID or name (e.g. Task, Epic).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"summary": schema.StringAttribute{
+				Description: "The issue summary.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The issue description as Markdown or plain text. It is converted to Atlassian Document Format.",
+				Optional:    true,
+			},
+			"labels": schema.SetAttribute{
+				Description: "Labels on the issue.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"component_ids": schema.SetAttribute{
+				Description: "IDs of the project components on the issue. Use the IDs from jira_project_component.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"fix_version_ids": schema.SetAttribute{
+				Description: "IDs of the fix versions of the issue. Use the IDs from jira_project_version.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"parent_key": schema.StringAttribute{
+				Description: "The key of the parent issue (an epic, or the parent of a subtask).",
+				Optional:    true,
+			},
+			"assignee_account_id": schema.StringAttribute{
+				Description: "The Atlassian account ID of the assignee.",
+				Optional:    true,
+			},
+			"fields": schema.StringAttribute{
+				Description: "JSON object of additional fields to set, keyed by field ID (e.g. customfield_10010 from jira_custom_field). Written on create and update only; changes made outside Terraform are not detected.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The target status name. When it differs from the current status, the issue is moved through the matching transition.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"links": schema.ListNestedAttribute{
+				Description: "Links from this issue to other issues. Only links listed here are managed.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The link type name (e.g. Blocks). Use the name from jira_issue_link_type.",
+							Required:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description: "outward when this issue uses the outward description of the link type (e.g. blocks) toward issue_key, inward for the inward description (e.g. is blocked by).",
+							Required:    true,
+						},
+						"issue_key": schema.StringAttribute{
+							Description: "The key of the linked issue.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *IssueResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *IssueResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config IssueResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Fields.IsNull() && !config.Fields.IsUnknown() {
+		var fields map[string]interface{}
+		if err := json.Unmarshal([]byte(config.Fields.ValueString()), &fields); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid fields JSON",
+				"fields must be a JSON object keyed by field ID: "+err.Error())
+		}
+	}
+
+	if !config.Links.IsNull() && !config.Links.IsUnknown() {
+		var links []types.Object
+		resp.Diagnostics.Append(config.Links.ElementsAs(ctx, &links, false)...)
+		for i, obj := range links {
+			direction, ok := obj.Attributes()["direction"].(types.String)
+			if !ok || direction.IsUnknown() || direction.IsNull() {
+				continue
+			}
+			if d := direction.ValueString(); d != "outward" && d != "inward" {
+				resp.Diagnostics.AddAttributeError(path.Root("links").AtListIndex(i).AtName("direction"),
+					"Invalid link direction", fmt.Sprintf("direction must be outward or inward, got %q.", d))
+			}
+		}
+	}
+}
+
+// idObjects converts a set of IDs into the [{"id": ...}] shape used by components and versions.
+func idObjects(ctx context.Context, set types.Set) ([]map[string]interface{}, diag.Diagnostics) {
+	result := []map[string]interface{}{}
+	if set.IsNull() || set.IsUnknown() {
+		return result, nil
+	}
+	var ids []string
+	diags := set.ElementsAs(ctx, &ids, false)
+	for _, id := range ids {
+		result = append(result, map[string]interface{}{"id": id})
+	}
+	return result, diags
+}
+
+// buildFields assembles the fields object for create and update. prior is the current
+// state on update and nil on create; it is used to clear attributes removed from config.
+func (r *IssueResource) buildFields(ctx context.Context, plan IssueResourceModel, prior *IssueResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fields := map[string]interface{}{}
+
+	// Extra fields go first so the dedicated attributes win on conflicts.
+	if !plan.Fields.IsNull() && !plan.Fields.IsUnknown() {
+		if err := json.Unmarshal([]byte(plan.Fields.ValueString()), &fields); err != nil {
+			diags.AddAttributeError(path.Root("fields"), "Invalid fields JSON", err.Error())
+			return nil, diags
+		}
+	}
+
+	fields["summary"] = plan.Summary.ValueString()
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		fields["description"] = adf.FromMarkdown(plan.Description.ValueString())
+	} else if prior != nil && !prior.Description.IsNull() {
+		fields["description"] = nil
+	}
+
+	if !plan.Labels.IsNull() && !plan.Labels.IsUnknown() {
+		var labels []string
+		diags.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+		fields["labels"] = labels
+	} else if prior != nil && !prior.Labels.IsNull() {
+		fields["labels"] = []string{}
+	}
+
+	if !plan.ComponentIDs.IsNull() || (prior != nil && !prior.ComponentIDs.IsNull()) {
+		components, d := idObjects(ctx, plan.ComponentIDs)
+		diags.Append(d...)
+		fields["components"] = components
+	}
+	if !plan.FixVersionIDs.IsNull() || (prior != nil && !prior.FixVersionIDs.IsNull()) {
+		versions, d := idObjects(ctx, plan.FixVersionIDs)
+		diags.Append(d...)
+		fields["fixVersions"] = versions
+	}
+
+	if !plan.ParentKey.IsNull() && !plan.ParentKey.IsUnknown() {
+		fields["parent"] = map[string]interface{}{"key": plan.ParentKey.ValueString()}
+	} else if prior != nil && !prior.ParentKey.IsNull() {
+		fields["parent"] = nil
+	}
+
+	if !plan.AssigneeAccountID.IsNull() && !plan.AssigneeAccountID.IsUnknown() {
+		fields["assignee"] = map[string]interface{}{"accountId": plan.AssigneeAccountID.ValueString()}
+	} else if prior != nil && !prior.AssigneeAccountID.IsNull() {
+		fields["assignee"] = nil
+	}
+
+	return fields, diags
+}
+
+func (r *IssueResource) fetchIssue(idOrKey string) (map[string]interface{}, error) {
+	params := url.Values{
+		"fields": {"project,issuetype,summary,description,labels,components,fixVersions,parent,assignee,status,issuelinks"},
+	}
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/issue/%s?%s", idOrKey, params.Encode()), &result)
+	return result, err
+}
+
+// parseIssueLinks converts the issuelinks field into links seen from the issue.
+func parseIssueLinks(fields map[string]interface{}) []remoteIssueLink {
+	raw, _ := fields["issuelinks"].([]interface{})
+	var links []remoteIssueLink
+	for _, item := range raw {
+		link, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		linkType, _ := link["type"].(map[string]interface{})
+		entry := remoteIssueLink{ID: fmt.Sprintf("%v", link["id"])}
+		entry.Type = fmt.Sprintf("%v", linkType["name"])
+		if other, ok := link["outwardIssue"].(map[string]interface{}); ok {
+			entry.Direction = "outward"
+			entry.IssueKey = fmt.Sprintf("%v", other["key"])
+		} else if other, ok := link["inwardIssue"].(map[string]interface{}); ok {
+			entry.Direction = "inward"
+			entry.IssueKey = fmt.Sprintf("%v", other["key"])
+		} else {
+			continue
+		}
+		links = append(links, entry)
+	}
+	return links
+}
+
+// applyIssue copies the issue fields onto the model. When all is false, links are limited
+// to those already in the model so links created by other resources do not show as drift.
+func (r *IssueResource) applyIssue(ctx context.Context, model *IssueResourceModel, result map[string]interface{}, all bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	model.Key = types.StringValue(fmt.Sprintf("%v", result["key"]))
+	fields, _ := result["fields"].(map[string]interface{})
+
+	if project, ok := fields["project"].(map[string]interface{}); ok {
+		model.ProjectKey = types.StringValue(fmt.Sprintf("%v", project["key"]))
+	}
+	if issueType, ok := fields["issuetype"].(map[string]interface{}); ok {
+		// Echo the form used in configuration: ID or name.
+		if model.IssueType.IsNull() || !isNumeric(model.IssueType.ValueString()) {
+			model.IssueType = types.StringValue(fmt.Sprintf("%v", issueType["name"]))
+		} else {
+			model.IssueType = types.StringValue(fmt.Sprintf("%v", issueType["id"]))
+		}
+	}
+	if summary, ok := fields["summary"].(string); ok {
+		model.Summary = types.StringValue(summary)
+	}
+	// Keep the configured Markdown when it produces the stored document, since
+	// several Markdown spellings map to the same ADF.
+	if fields["description"] == nil {
+		// A blank description is sent as no document, so keep it as configured.
+		if model.Description.IsNull() || adf.FromMarkdown(model.Description.ValueString()) != nil {
+			model.Description = types.StringNull()
+		}
+	} else if model.Description.IsNull() || !adf.Equal(adf.FromMarkdown(model.Description.ValueString()), fields["description"]) {
+		model.Description = optionalString(adf.ToMarkdown(fields["description"]))
+	}
+
+	var labels []string
+	if raw, ok := fields["labels"].([]interface{}); ok {
+		for _, l := range raw {
+			labels = append(labels, fmt.Sprintf("%v", l))
+		}
+	}
+	model.Labels = stringSetOrNull(ctx, labels, model.Labels, &diags)
+	model.ComponentIDs = stringSetOrNull(ctx, objectIDs(fields["components"]), model.ComponentIDs, &diags)
+	model.FixVersionIDs = stringSetOrNull(ctx, objectIDs(fields["fixVersions"]), model.FixVersionIDs, &diags)
+
+	model.ParentKey = types.StringNull()
+	if parent, ok := fields["parent"].(map[string]interface{}); ok {
+		model.ParentKey = types.StringValue(fmt.Sprintf("%v", parent["key"]))
+	}
+	model.AssigneeAccountID = types.StringNull()
+	if assignee, ok := fields["assignee"].(map[string]interface{}); ok {
+		model.AssigneeAccountID = types.StringValue(fmt.Sprintf("%v", assignee["accountId"]))
+	}
+	if status, ok := fields["status"].(map[string]interface{}); ok {
+		name := fmt.Sprintf("%v", status["name"])
+		// Keep the configured casing when it matches.
+		if !strings.EqualFold(name, model.Status.ValueString()) {
+			model.Status = types.StringValue(name)
+		}
+	}
+
+	links, d := issueLinksToList(ctx, model.Links, parseIssueLinks(fields), all)
+	diags.Append(d...)
+	model.Links = links
+
+	return diags
+}
+
+// objectIDs returns the id of every object in a list such as components or fixVersions.
+func objectIDs(raw interface{}) []string {
+	items, _ := raw.([]interface{})
+	var ids []string
+	for _, item := range items {
+		if obj, ok := item.(map[string]interface{}); ok {
+			ids = append(ids, fmt.Sprintf("%v", obj["id"]))
+		}
+	}
+	return ids
+}
+
+// stringSetOrNull builds a set from values, keeping a null prior value null when there are none.
+func stringSetOrNull(ctx context.Context, values []string, prior types.Set, diags *diag.Diagnostics) types.Set {
+	if len(values) == 0 && prior.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+	if values == nil {
+		values = []string{}
+	}
+	set, d := types.SetValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return set
+}
+
+func issueLinksToList(ctx context.Context, prior types.List, remote []remoteIssueLink, all bool) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var priorEntries []issueLinkEntry
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorEntries, false)...)
+		if diags.HasError() {
+			return types.ListNull(issueLinkObjectType), diags
+		}
+	}
+
+	var ordered []issueLinkEntry
+	used := make([]bool, len(remote))
+	for _, p := range priorEntries {
+		for i, l := range remote {
+			if !used[i] && issueLinkKey(l.issueLinkEntry) == issueLinkKey(p) {
+				ordered = append(ordered, p)
+				used[i] = true
+				break
+			}
+		}
+	}
+	if all {
+		for i, l := range remote {
+			if !used[i] {
+				ordered = append(ordered, l.issueLinkEntry)
+			}
+		}
+	}
+
+	if len(ordered) == 0 {
+		if prior.IsNull() {
+			return types.ListNull(issueLinkObjectType), diags
+		}
+		return types.ListValueMust(issueLinkObjectType, []attr.Value{}), diags
+	}
+
+	var values []attr.Value
+	for _, l := range ordered {
+		obj, d := types.ObjectValue(issueLinkObjectType.AttrTypes, map[string]attr.Value{
+			"type":      types.StringValue(l.Type),
+			"direction": types.StringValue(l.Direction),
+			"issue_key": types.StringValue(l.IssueKey),
+		})
+		diags.Append(d...)
+		values = append(values, obj)
+	}
+	listVal, d := types.ListValue(issueLinkObjectType, values)
+	diags.Append(d...)
+	return listVal, diags
+}
+
+// syncLinks creates the planned links that are missing and removes links dropped since the prior state.
+func (r *IssueResource) syncLinks(ctx context.Context, issueKey string, plan, prior types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var wanted, previous []issueLinkEntry
+	if !plan.IsNull() && !plan.IsUnknown() {
+		diags.Append(plan.ElementsAs(ctx, &wanted, false)...)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &previous, false)...)
+	}
+	if diags.HasError() || (len(wanted) == 0 && len(previous) == 0) {
+		return diags
+	}
+
+	result, err := r.fetchIssue(issueKey)
+	if err != nil {
+		diags.AddError("Error reading issue links", err.Error())
+		return diags
+	}
+	fields, _ := result["fields"].(map[string]interface{})
+	current := parseIssueLinks(fields)
+
+	wantedKeys := make(map[string]bool, len(wanted))
+	for _, l := range wanted {
+		wantedKeys[issueLinkKey(l)] = true
+	}
+	previousKeys := make(map[string]bool, len(previous))
+	for _, l := range previous {
+		previousKeys[issueLinkKey(l)] = true
+	}
+
+	currentKeys := make(map[string]bool, len(current))
+	for _, l := range current {
+		key := issueLinkKey(l.issueLinkEntry)
+		currentKeys[key] = true
+		// Only remove links this resource managed before.
+		if wantedKeys[key] || !previousKeys[key] {
+			continue
+		}
+		if err := r.client.Delete(fmt.Sprintf("/rest/api/3/issueLink/%s", l.ID)); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error removing issue link", err.Error())
+			return diags
+		}
+	}
+
+	for _, l := range wanted {
+		if currentKeys[issueLinkKey(l)] {
+			continue
+		}
+		// From this issue, an outward link points at the outward issue of the link.
+		this := map[string]interface{}{"key": issueKey}
+		other := map[string]interface{}{"key": l.IssueKey}
+		body := map[string]interface{}{
+			"type":         map[string]interface{}{"name": l.Type},
+			"inwardIssue":  this,
+			"outwardIssue": other,
+		}
+		if l.Direction == "inward" {
+			body["inwardIssue"] = other
+			body["outwardIssue"] = this
+		}
+		if err := r.client.Post("/rest/api/3/issueLink", body, nil); err != nil {
+			diags.AddError("Error creating issue link", err.Error())
+			return diags
+		}
+		currentKeys[issueLinkKey(l)] = true
+	}
+
+	return diags
+}
+
+// transitionTo moves the issue to the named status through one of its available transitions.
+func (r *IssueResource) transitionTo(issueKey, status string) error {
+	var result struct {
+		Transitions []struct {
+			ID string `json:"id"`
+			To struct {
+				Name string `json:"name"`
+			} `json:"to"`
+		} `json:"transitions"`
+	}
+	if err := r.client.Get(fmt.Sprintf("/rest/api/3/issue/%s/transitions", issueKey), &result); err != nil {
+		return err
+	}
+
+	var available []string
+	for _, t := range result.Transitions {
+		if strings.EqualFold(t.To.Name, status) {
+			body := map[string]interface{}{
+				"transition": map[string]interface{}{"id": t.ID},
+			}
+			return r.client.Post(fmt.Sprintf("/rest/api/3/issue/%s/transitions", issueKey), body, nil)
+		}
+		available = append(available, t.To.Name)
+	}
+	return fmt.Errorf("no transition from the current status of %s leads to %q; available target statuses: %s",
+		issueKey, status, strings.Join(available, ", "))
+}
+
+// currentStatus returns the name of the issue's status.
+func (r *IssueResource) currentStatus(issueKey string) (string, error) {
+	var result struct {
+		Fields struct {
+			Status struct {
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"fields"`
+	}
+	if err := r.client.Get(fmt.Sprintf("/rest/api/3/issue/%s?fields=status", issueKey), &result); err != nil {
+		return "", err
+	}
+	return result.Fields.Status.Name, nil
+}
+
+// ensureStatus transitions the issue when a target status is configured and returns the resulting status.
+func (r *IssueResource) ensureStatus(issueKey string, target types.String) (string, error) {
+	status, err := r.currentStatus(issueKey)
+	if err != nil {
+		return "", err
+	}
+	if target.IsNull() || target.IsUnknown() || strings.EqualFold(status, target.ValueString()) {
+		return status, nil
+	}
+	if err := r.transitionTo(issueKey, target.ValueString()); err != nil {
+		return "", err
+	}
+	return target.ValueString(), nil
+}
+
+func (r *IssueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IssueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, diags := r.buildFields(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	fields["project"] = map[string]interface{}{"key": plan.ProjectKey.ValueString()}
+	if isNumeric(plan.IssueType.ValueString()) {
+		fields["issuetype"] = map[string]interface{}{"id": plan.IssueType.ValueString()}
+	} else {
+		fields["issuetype"] = map[string]interface{}{"name": plan.IssueType.ValueString()}
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post("/rest/api/3/issue", map[string]interface{}{"fields": fields}, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating issue", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	plan.Key = types.StringValue(fmt.Sprintf("%v", result["key"]))
+
+	// Save the issue before links and transitions so a failure there does not orphan it.
+	created := plan
+	if created.Status.IsUnknown() {
+		created.Status = types.StringNull()
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, created)...)
+
+	resp.Diagnostics.Append(r.syncLinks(ctx, plan.Key.ValueString(), plan.Links, types.ListNull(issueLinkObjectType))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := r.ensureStatus(plan.Key.ValueString(), plan.Status)
+	if err != nil {
+		resp.Diagnostics.AddError("Error transitioning issue", err.Error())
+		return
+	}
+	if plan.Status.IsUnknown() {
+		plan.Status = types.StringValue(status)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *IssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IssueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.fetchIssue(state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading issue", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyIssue(ctx, &state, result, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *IssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan IssueResourceModel
+	var state IssueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, diags := r.buildFields(ctx, plan, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/issue/%s", plan.ID.ValueString()), map[string]interface{}{"fields": fields}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating issue", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncLinks(ctx, plan.Key.ValueString(), plan.Links, state.Links)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := r.ensureStatus(plan.Key.ValueString(), plan.Status)
+	if err != nil {
+		resp.Diagnostics.AddError("Error transitioning issue", err.Error())
+		return
+	}
+	if plan.Status.IsUnknown() {
+		plan.Status = types.StringValue(status)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *IssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IssueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{"deleteSubtasks": {"true"}}
+	err := r.client.DeleteWithQuery(fmt.Sprintf("/rest/api/3/issue/%s", state.ID.ValueString()), params)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting issue", err.Error())
+		return
+	}
+}
+
+func (r *IssueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by issue key or ID.
+	result, err := r.fetchIssue(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing issue", err.Error())
+		return
+	}
+
+	state := IssueResourceModel{
+		IssueType:     types.StringNull(),
+		Status:        types.StringNull(),
+		Fields:        types.StringNull(),
+		Labels:        types.SetNull(types.StringType),
+		ComponentIDs:  types.SetNull(types.StringType),
+		FixVersionIDs: types.SetNull(types.StringType),
+		Links:         types.ListNull(issueLinkObjectType),
+	}
+	resp.Diagnostics.Append(r.applyIssue(ctx, &state, result, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &IssueLinkTypeResource{}
+var _ resource.ResourceWithImportState = &IssueLinkTypeResource{}
+
+type IssueLinkTypeResource struct {
+	client *client.Client
+}
+
+type IssueLinkTypeResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Inward  types.String `tfsdk:"inward"`
+	Outward types.String `tfsdk:"outward"`
+}
+
+func NewIssueLinkTypeResource() resource.Resource {
+	return &IssueLinkTypeResource{}
+}
+
+func (r *IssueLinkTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_link_type"
+}
+
+func (r *IssueLinkTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA issue link type.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The issue link type ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The issue link type name (e.g. Blocks).",
+				Required:    true,
+			},
+			"inward": schema.StringAttribute{
+				Description: "The description of the inward link (e.g. is blocked by).",
+				Required:    true,
+			},
+			"outward": schema.StringAttribute{
+				Description: "The description of the outward link (e.g. blocks).",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *IssueLinkTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *IssueLinkTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{
+		"name":    plan.Name.ValueString(),
+		"inward":  plan.Inward.ValueString(),
+		"outward": plan.Outward.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post("/rest/api/3/issueLinkType", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating issue link type", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *IssueLinkTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/issueLinkType/%s", state.ID.ValueString()), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading issue link type", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	state.Inward = types.StringValue(fmt.Sprintf("%v", result["inward"]))
+	state.Outward = types.StringValue(fmt.Sprintf("%v", result["outward"]))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *IssueLinkTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan IssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{
+		"name":    plan.Name.ValueString(),
+		"inward":  plan.Inward.ValueString(),
+		"outward": plan.Outward.ValueString(),
+	}
+
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/issueLinkType/%s", plan.ID.ValueString()), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating issue link type", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *IssueLinkTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(fmt.Sprintf("/rest/api/3/issueLinkType/%s", state.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting issue link type", err.Error())
+		return
+	}
+}
+
+func (r *IssueLinkTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/issueLinkType/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing issue link type", err.Error())
+		return
+	}
+
+	state := IssueLinkTypeResourceModel{
+		ID:      types.StringValue(fmt.Sprintf("%v", result["id"])),
+		Name:    types.StringValue(fmt.Sprintf("%v", result["name"])),
+		Inward:  types.StringValue(fmt.Sprintf("%v", result["inward"])),
+		Outward: types.StringValue(fmt.Sprintf("%v", result["outward"])),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
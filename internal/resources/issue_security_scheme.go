@@ -0,0 +1,685 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &IssueSecuritySchemeResource{}
+var _ resource.ResourceWithImportState = &IssueSecuritySchemeResource{}
+var _ resource.ResourceWithValidateConfig = &IssueSecuritySchemeResource{}
+
+type IssueSecuritySchemeResource struct {
+	client *client.Client
+}
+
+type IssueSecuritySchemeResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	DefaultLevel types.String `tfsdk:"default_level"`
+	Levels       types.List   `tfsdk:"levels"`
+	LevelIDs     types.Map    `tfsdk:"level_ids"`
+}
+
+var securityLevelMemberObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":      types.StringType,
+		"parameter": types.StringType,
+	},
+}
+
+var securityLevelObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":        types.StringType,
+		"description": types.StringType,
+		"members":     types.ListType{ElemType: securityLevelMemberObjectType},
+	},
+}
+
+// securityLevel is a single issue security level as configured in Terraform.
+type securityLevel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Members     types.List   `tfsdk:"members"`
+}
+
+type securityLevelMember struct {
+	Type      types.String `tfsdk:"type"`
+	Parameter types.String `tfsdk:"parameter"`
+}
+
+// remoteSecurityLevel is a security level with its members as returned by the API.
+type remoteSecurityLevel struct {
+	ID          string
+	Name        string
+	Description string
+	Members     []remoteSecurityLevelMember
+}
+
+type remoteSecurityLevelMember struct {
+	ID        string
+	Type      string
+	Parameter string
+}
+
+func NewIssueSecuritySchemeResource() resource.Resource {
+	return &IssueSecuritySchemeResource{}
+}
+
+func (r *IssueSecuritySchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_security_scheme"
+}
+
+func (r *IssueSecuritySchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA issue security scheme and its security levels.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The issue security scheme ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The issue security scheme name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The issue security scheme description.",
+				Optional:    true,
+			},
+			"default_level": schema.StringAttribute{
+				Description: "Name of the security level applied to new issues by default. Must match one of the levels.",
+				Optional:    true,
+			},
+			"levels": schema.ListNestedAttribute{
+				Description: "Security levels in this scheme. Levels are matched by name.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The security level name. Must be unique within the scheme.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The security level description.",
+							Optional:    true,
+						},
+						"members": schema.ListNestedAttribute{
+							Description: "Who can see issues with this security level.",
+							Optional:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "Member type: group, user, projectRole, reporter, assignee, projectLead, applicationRole, userCustomField or groupCustomField.",
+										Required:    true,
+									},
+									"parameter": schema.StringAttribute{
+										Description: "Member parameter: group name, account ID, role ID or custom field ID. Leave empty for reporter, assignee and projectLead.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"level_ids": schema.MapAttribute{
+				Description: "Map of security level name to security level ID.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *IssueSecuritySchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *IssueSecuritySchemeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config IssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Levels.IsUnknown() || config.DefaultLevel.IsUnknown() {
+		return
+	}
+
+	var levels []securityLevel
+	if !config.Levels.IsNull() {
+		resp.Diagnostics.Append(config.Levels.ElementsAs(ctx, &levels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	names := make(map[string]bool, len(levels))
+	for _, l := range levels {
+		if l.Name.IsUnknown() {
+			return
+		}
+		if names[l.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("levels"), "Duplicate security level",
+				fmt.Sprintf("Security level '%s' is defined more than once.", l.Name.ValueString()))
+			return
+		}
+		names[l.Name.ValueString()] = true
+	}
+
+	if !config.DefaultLevel.IsNull() && !names[config.DefaultLevel.ValueString()] {
+		resp.Diagnostics.AddAttributeError(path.Root("default_level"), "Unknown default security level",
+			fmt.Sprintf("default_level '%s' does not match any level in levels.", config.DefaultLevel.ValueString()))
+	}
+}
+
+// planLevels reads the configured levels and their members.
+func planLevels(ctx context.Context, plan IssueSecuritySchemeResourceModel) ([]remoteSecurityLevel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if plan.Levels.IsNull() || plan.Levels.IsUnknown() {
+		return nil, diags
+	}
+
+	var levels []securityLevel
+	diags.Append(plan.Levels.ElementsAs(ctx, &levels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var result []remoteSecurityLevel
+	for _, l := range levels {
+		level := remoteSecurityLevel{
+			Name:        l.Name.ValueString(),
+			Description: l.Description.ValueString(),
+		}
+		if !l.Members.IsNull() && !l.Members.IsUnknown() {
+			var members []securityLevelMember
+			diags.Append(l.Members.ElementsAs(ctx, &members, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			for _, m := range members {
+				level.Members = append(level.Members, remoteSecurityLevelMember{
+					Type:      m.Type.ValueString(),
+					Parameter: m.Parameter.ValueString(),
+				})
+			}
+		}
+		result = append(result, level)
+	}
+	return result, diags
+}
+
+func buildSecurityLevelMembers(members []remoteSecurityLevelMember) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(members))
+	for _, m := range members {
+		member := map[string]interface{}{"type": m.Type}
+		if m.Parameter != "" {
+			member["parameter"] = m.Parameter
+		}
+		result = append(result, member)
+	}
+	return result
+}
+
+func securityMemberKey(memberType, parameter string) string {
+	return strings.ToLower(memberType) + "|" + parameter
+}
+
+// fetchScheme reads the scheme, its levels, and the members of each level.
+func (r *IssueSecuritySchemeResource) fetchScheme(id string) (map[string]interface{}, []remoteSecurityLevel, error) {
+	var result map[string]interface{}
+	if err := r.client.Get(fmt.Sprintf("/rest/api/3/issuesecurityschemes/%s", id), &result); err != nil {
+		return nil, nil, err
+	}
+
+	var levels []remoteSecurityLevel
+	if raw, ok := result["levels"].([]interface{}); ok {
+		for _, item := range raw {
+			l, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			level := remoteSecurityLevel{
+				ID:   fmt.Sprintf("%v", l["id"]),
+				Name: fmt.Sprintf("%v", l["name"]),
+			}
+			if desc, ok := l["description"].(string); ok {
+				level.Description = desc
+			}
+			levels = append(levels, level)
+		}
+	}
+
+	// Members are paginated per scheme; group them by level.
+	byLevel := make(map[string][]remoteSecurityLevelMember)
+	members, err := r.client.GetAllPages("/rest/api/3/issuesecurityschemes/level/member", url.Values{
+		"schemeId":   {id},
+		"maxResults": {"100"},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, v := range members {
+		member := remoteSecurityLevelMember{ID: fmt.Sprintf("%v", v["id"])}
+		if holder, ok := v["holder"].(map[string]interface{}); ok {
+			member.Type = fmt.Sprintf("%v", holder["type"])
+			if param, ok := holder["parameter"]; ok && param != nil {
+				member.Parameter = fmt.Sprintf("%v", param)
+			}
+		}
+		levelID := fmt.Sprintf("%v", v["issueSecurityLevelId"])
+		byLevel[levelID] = append(byLevel[levelID], member)
+	}
+	for i := range levels {
+		levels[i].Members = byLevel[levels[i].ID]
+	}
+
+	return result, levels, nil
+}
+
+// applyRemoteScheme copies the remote scheme into the model, keeping the order of the prior levels and members.
+func applyRemoteScheme(ctx context.Context, model *IssueSecuritySchemeResourceModel, result map[string]interface{}, remote []remoteSecurityLevel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	if desc, ok := result["description"].(string); ok && desc != "" {
+		model.Description = types.StringValue(desc)
+	}
+
+	prior, d := planLevels(ctx, *model)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Order levels as previously known, new remote levels go last.
+	var ordered []remoteSecurityLevel
+	used := make(map[string]bool)
+	for _, p := range prior {
+		for _, l := range remote {
+			if l.Name == p.Name && !used[l.ID] {
+				ordered = append(ordered, orderSecurityMembers(l, p.Members))
+				used[l.ID] = true
+				break
+			}
+		}
+	}
+	for _, l := range remote {
+		if !used[l.ID] {
+			ordered = append(ordered, l)
+		}
+	}
+
+	levelIDs := make(map[string]string, len(ordered))
+	defaultLevelID := fmt.Sprintf("%v", result["defaultSecurityLevelId"])
+	model.DefaultLevel = types.StringNull()
+
+	var values []attr.Value
+	for _, l := range ordered {
+		levelIDs[l.Name] = l.ID
+		if l.ID == defaultLevelID {
+			model.DefaultLevel = types.StringValue(l.Name)
+		}
+
+		var memberValues []attr.Value
+		for _, m := range l.Members {
+			obj, d := types.ObjectValue(securityLevelMemberObjectType.AttrTypes, map[string]attr.Value{
+				"type":      types.StringValue(m.Type),
+				"parameter": optionalString(m.Parameter),
+			})
+			diags.Append(d...)
+			memberValues = append(memberValues, obj)
+		}
+		members := types.ListNull(securityLevelMemberObjectType)
+		if len(memberValues) > 0 {
+			members, d = types.ListValue(securityLevelMemberObjectType, memberValues)
+			diags.Append(d...)
+		}
+
+		obj, d := types.ObjectValue(securityLevelObjectType.AttrTypes, map[string]attr.Value{
+			"name":        types.StringValue(l.Name),
+			"description": optionalString(l.Description),
+			"members":     members,
+		})
+		diags.Append(d...)
+		values = append(values, obj)
+	}
+
+	if len(values) > 0 {
+		listVal, d := types.ListValue(securityLevelObjectType, values)
+		diags.Append(d...)
+		model.Levels = listVal
+	} else {
+		model.Levels = types.ListNull(securityLevelObjectType)
+	}
+
+	mapVal, d := types.MapValueFrom(ctx, types.StringType, levelIDs)
+	diags.Append(d...)
+	model.LevelIDs = mapVal
+
+	return diags
+}
+
+// orderSecurityMembers sorts the members of a remote level in the order of the prior members.
+func orderSecurityMembers(level remoteSecurityLevel, prior []remoteSecurityLevelMember) remoteSecurityLevel {
+	var ordered []remoteSecurityLevelMember
+	used := make([]bool, len(level.Members))
+	for _, p := range prior {
+		for i, m := range level.Members {
+			if !used[i] && securityMemberKey(m.Type, m.Parameter) == securityMemberKey(p.Type, p.Parameter) {
+				ordered = append(ordered, m)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, m := range level.Members {
+		if !used[i] {
+			ordered = append(ordered, m)
+		}
+	}
+	level.Members = ordered
+	return level
+}
+
+// setDefaultLevel sets the default security level of the scheme. An empty levelID clears it.
+func (r *IssueSecuritySchemeResource) setDefaultLevel(schemeID, levelID string) error {
+	if levelID == "" {
+		levelID = "-1"
+	}
+	body := map[string]interface{}{
+		"defaultValues": []map[string]interface{}{
+			{"issueSecuritySchemeId": schemeID, "defaultLevelId": levelID},
+		},
+	}
+	var result map[string]interface{}
+	if err := r.client.Put("/rest/api/3/issuesecurityschemes/default", body, &result); err != nil {
+		return err
+	}
+	if taskID := client.TaskIDFromResponse(result); taskID != "" {
+		return r.client.WaitForTask(taskID)
+	}
+	return nil
+}
+
+func (r *IssueSecuritySchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	levels, diags := planLevels(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueString()
+	}
+	if len(levels) > 0 {
+		var levelBodies []map[string]interface{}
+		for _, l := range levels {
+			levelBody := map[string]interface{}{
+				"name":      l.Name,
+				"isDefault": l.Name == plan.DefaultLevel.ValueString(),
+				"members":   buildSecurityLevelMembers(l.Members),
+			}
+			if l.Description != "" {
+				levelBody["description"] = l.Description
+			}
+			levelBodies = append(levelBodies, levelBody)
+		}
+		body["levels"] = levelBodies
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post("/rest/api/3/issuesecurityschemes", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating issue security scheme", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+
+	// Read back to learn the generated level IDs.
+	_, remote, err := r.fetchScheme(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading issue security scheme", err.Error())
+		return
+	}
+	levelIDs := make(map[string]string, len(remote))
+	for _, l := range remote {
+		levelIDs[l.Name] = l.ID
+	}
+	mapVal, diags := types.MapValueFrom(ctx, types.StringType, levelIDs)
+	resp.Diagnostics.Append(diags...)
+	plan.LevelIDs = mapVal
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *IssueSecuritySchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, levels, err := r.fetchScheme(state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading issue security scheme", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(applyRemoteScheme(ctx, &state, result, levels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *IssueSecuritySchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan IssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wanted, diags := planLevels(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemeID := plan.ID.ValueString()
+	body := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueString()
+	}
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/issuesecurityschemes/%s", schemeID), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating issue security scheme", err.Error())
+		return
+	}
+
+	_, current, err := r.fetchScheme(schemeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading issue security scheme", err.Error())
+		return
+	}
+	currentByName := make(map[string]remoteSecurityLevel, len(current))
+	for _, l := range current {
+		currentByName[l.Name] = l
+	}
+
+	// Add new levels and reconcile existing ones.
+	wantedNames := make(map[string]bool, len(wanted))
+	for _, l := range wanted {
+		wantedNames[l.Name] = true
+		existing, ok := currentByName[l.Name]
+		if !ok {
+			levelBody := map[string]interface{}{
+				"name":    l.Name,
+				"members": buildSecurityLevelMembers(l.Members),
+			}
+			if l.Description != "" {
+				levelBody["description"] = l.Description
+			}
+			addBody := map[string]interface{}{"levels": []map[string]interface{}{levelBody}}
+			if err := r.client.Put(fmt.Sprintf("/rest/api/3/issuesecurityschemes/%s/level", schemeID), addBody, nil); err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Error adding security level '%s'", l.Name), err.Error())
+				return
+			}
+			continue
+		}
+
+		if existing.Description != l.Description {
+			levelBody := map[string]interface{}{"description": l.Description}
+			if err := r.client.Put(fmt.Sprintf("/rest/api/3/issuesecurityschemes/%s/level/%s", schemeID, existing.ID), levelBody, nil); err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Error updating security level '%s'", l.Name), err.Error())
+				return
+			}
+		}
+
+		wantedMembers := make(map[string]bool, len(l.Members))
+		for _, m := range l.Members {
+			wantedMembers[securityMemberKey(m.Type, m.Parameter)] = true
+		}
+		currentMembers := make(map[string]bool, len(existing.Members))
+		for _, m := range existing.Members {
+			key := securityMemberKey(m.Type, m.Parameter)
+			currentMembers[key] = true
+			if wantedMembers[key] {
+				continue
+			}
+			err := r.client.Delete(fmt.Sprintf("/rest/api/3/issuesecurityschemes/%s/level/%s/member/%s", schemeID, existing.ID, m.ID))
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Error removing member from security level '%s'", l.Name), err.Error())
+				return
+			}
+		}
+		var missing []remoteSecurityLevelMember
+		for _, m := range l.Members {
+			if !currentMembers[securityMemberKey(m.Type, m.Parameter)] {
+				missing = append(missing, m)
+			}
+		}
+		if len(missing) > 0 {
+			memberBody := map[string]interface{}{"members": buildSecurityLevelMembers(missing)}
+			err := r.client.Put(fmt.Sprintf("/rest/api/3/issuesecurityschemes/%s/level/%s/member", schemeID, existing.ID), memberBody, nil)
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Error adding members to security level '%s'", l.Name), err.Error())
+				return
+			}
+		}
+	}
+
+	// Levels are removed asynchronously; the default level has to move first.
+	_, refreshed, err := r.fetchScheme(schemeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading issue security scheme", err.Error())
+		return
+	}
+	levelIDs := make(map[string]string, len(refreshed))
+	for _, l := range refreshed {
+		levelIDs[l.Name] = l.ID
+	}
+
+	defaultLevelID := ""
+	if !plan.DefaultLevel.IsNull() && !plan.DefaultLevel.IsUnknown() {
+		defaultLevelID = levelIDs[plan.DefaultLevel.ValueString()]
+	}
+	if err := r.setDefaultLevel(schemeID, defaultLevelID); err != nil {
+		resp.Diagnostics.AddError("Error setting default security level", err.Error())
+		return
+	}
+
+	for _, l := range refreshed {
+		if wantedNames[l.Name] {
+			continue
+		}
+		var result map[string]interface{}
+		err := r.client.DeleteWithResult(fmt.Sprintf("/rest/api/3/issuesecurityschemes/%s/level/%s", schemeID, l.ID), &result)
+		if err == nil {
+			if taskID := client.TaskIDFromResponse(result); taskID != "" {
+				err = r.client.WaitForTask(taskID)
+			}
+		}
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error removing security level '%s'", l.Name), err.Error())
+			return
+		}
+		delete(levelIDs, l.Name)
+	}
+
+	mapVal, diags := types.MapValueFrom(ctx, types.StringType, levelIDs)
+	resp.Diagnostics.Append(diags...)
+	plan.LevelIDs = mapVal
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *IssueSecuritySchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(fmt.Sprintf("/rest/api/3/issuesecurityschemes/%s", state.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting issue security scheme", err.Error())
+		return
+	}
+}
+
+func (r *IssueSecuritySchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	result, levels, err := r.fetchScheme(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing issue security scheme", err.Error())
+		return
+	}
+
+	state := IssueSecuritySchemeResourceModel{
+		ID:     types.StringValue(fmt.Sprintf("%v", result["id"])),
+		Levels: types.ListNull(securityLevelObjectType),
+	}
+	resp.Diagnostics.Append(applyRemoteScheme(ctx, &state, result, levels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
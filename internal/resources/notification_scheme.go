@@ -0,0 +1,493 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &NotificationSchemeResource{}
+var _ resource.ResourceWithImportState = &NotificationSchemeResource{}
+
+type NotificationSchemeResource struct {
+	client *client.Client
+}
+
+type NotificationSchemeResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Notifications types.List   `tfsdk:"notifications"`
+}
+
+var notificationObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"event":             types.StringType,
+		"notification_type": types.StringType,
+		"parameter":         types.StringType,
+	},
+}
+
+// notificationEntry is a single notification as configured in Terraform.
+// Event holds either the numeric event ID or the event name.
+type notificationEntry struct {
+	Event            string       `tfsdk:"event"`
+	NotificationType string       `tfsdk:"notification_type"`
+	Parameter        types.String `tfsdk:"parameter"`
+}
+
+// remoteNotification is a single notification as returned by the API.
+type remoteNotification struct {
+	ID               string
+	EventID          string
+	EventName        string
+	NotificationType string
+	Parameter        string
+}
+
+func NewNotificationSchemeResource() resource.Resource {
+	return &NotificationSchemeResource{}
+}
+
+func (r *NotificationSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_scheme"
+}
+
+func (r *NotificationSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA notification scheme.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The notification scheme ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The notification scheme name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The notification scheme description.",
+				Optional:    true,
+			},
+			"notifications": schema.ListNestedAttribute{
+				Description: "List of notifications. Each entry sends the event to one recipient.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"event": schema.StringAttribute{
+							Description: "Event ID (e.g. 1) or event name (e.g. Issue created).",
+							Required:    true,
+						},
+						"notification_type": schema.StringAttribute{
+							Description: "Recipient type: CurrentAssignee, Reporter, CurrentUser, ProjectLead, ComponentLead, User, Group, ProjectRole, EmailAddress, AllWatchers, UserCustomField, GroupCustomField.",
+							Required:    true,
+						},
+						"parameter": schema.StringAttribute{
+							Description: "Recipient parameter: group name, role ID, account ID, email address or custom field ID. Leave empty for types that take none.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *NotificationSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+// listEvents returns all JIRA events keyed by lowercase name, mapped to their ID.
+func (r *NotificationSchemeResource) listEvents() (map[string]string, error) {
+	var events []map[string]interface{}
+	if err := r.client.Get("/rest/api/3/events", &events); err != nil {
+		return nil, err
+	}
+	byName := make(map[string]string, len(events))
+	for _, e := range events {
+		byName[strings.ToLower(fmt.Sprintf("%v", e["name"]))] = fmt.Sprintf("%v", e["id"])
+	}
+	return byName, nil
+}
+
+// planNotifications reads the configured notifications and resolves event names to IDs.
+func (r *NotificationSchemeResource) planNotifications(ctx context.Context, plan NotificationSchemeResourceModel) ([]remoteNotification, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if plan.Notifications.IsNull() || plan.Notifications.IsUnknown() {
+		return nil, diags
+	}
+
+	var entries []notificationEntry
+	diags.Append(plan.Notifications.ElementsAs(ctx, &entries, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var events map[string]string
+	var result []remoteNotification
+	for _, e := range entries {
+		eventID := e.Event
+		if !isNumeric(eventID) {
+			if events == nil {
+				var err error
+				events, err = r.listEvents()
+				if err != nil {
+					diags.AddError("Error listing events", err.Error())
+					return nil, diags
+				}
+			}
+			id, ok := events[strings.ToLower(e.Event)]
+			if !ok {
+				diags.AddError("Unknown event", fmt.Sprintf("No JIRA event named '%s' found.", e.Event))
+				return nil, diags
+			}
+			eventID = id
+		}
+		result = append(result, remoteNotification{
+			EventID:          eventID,
+			EventName:        e.Event,
+			NotificationType: e.NotificationType,
+			Parameter:        e.Parameter.ValueString(),
+		})
+	}
+	return result, diags
+}
+
+// buildNotificationEvents groups notifications by event in the shape the API expects.
+func buildNotificationEvents(notifications []remoteNotification) []map[string]interface{} {
+	var order []string
+	grouped := make(map[string][]map[string]interface{})
+	for _, n := range notifications {
+		if _, ok := grouped[n.EventID]; !ok {
+			order = append(order, n.EventID)
+		}
+		entry := map[string]interface{}{"notificationType": n.NotificationType}
+		if n.Parameter != "" {
+			entry["parameter"] = n.Parameter
+		}
+		grouped[n.EventID] = append(grouped[n.EventID], entry)
+	}
+
+	var events []map[string]interface{}
+	for _, id := range order {
+		events = append(events, map[string]interface{}{
+			"event":         map[string]interface{}{"id": id},
+			"notifications": grouped[id],
+		})
+	}
+	return events
+}
+
+// fetchNotifications reads the scheme with all notifications expanded.
+func (r *NotificationSchemeResource) fetchNotifications(id string) (map[string]interface{}, []remoteNotification, error) {
+	var result map[string]interface{}
+	if err := r.client.Get(fmt.Sprintf("/rest/api/3/notificationscheme/%s?expand=all", id), &result); err != nil {
+		return nil, nil, err
+	}
+
+	var notifications []remoteNotification
+	if events, ok := result["notificationSchemeEvents"].([]interface{}); ok {
+		for _, ev := range events {
+			evMap, ok := ev.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			eventID, eventName := "", ""
+			if event, ok := evMap["event"].(map[string]interface{}); ok {
+				eventID = fmt.Sprintf("%v", event["id"])
+				if name, ok := event["name"].(string); ok {
+					eventName = name
+				}
+			}
+			items, _ := evMap["notifications"].([]interface{})
+			for _, item := range items {
+				n, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				rn := remoteNotification{
+					ID:               fmt.Sprintf("%v", n["id"]),
+					EventID:          eventID,
+					EventName:        eventName,
+					NotificationType: fmt.Sprintf("%v", n["notificationType"]),
+				}
+				if param, ok := n["parameter"]; ok && param != nil {
+					rn.Parameter = fmt.Sprintf("%v", param)
+				}
+				notifications = append(notifications, rn)
+			}
+		}
+	}
+	return result, notifications, nil
+}
+
+func notificationKey(eventID, notificationType, parameter string) string {
+	return eventID + "|" + strings.ToLower(notificationType) + "|" + parameter
+}
+
+// notificationsToList converts remote notifications into the Terraform list, keeping the
+// order of the prior value and echoing event names for entries that were configured by name.
+func notificationsToList(ctx context.Context, prior types.List, remote []remoteNotification) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var priorEntries []notificationEntry
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorEntries, false)...)
+		if diags.HasError() {
+			return types.ListNull(notificationObjectType), diags
+		}
+	}
+
+	byName := make(map[string]bool)
+	for _, p := range priorEntries {
+		if !isNumeric(p.Event) {
+			byName[strings.ToLower(p.Event)] = true
+		}
+	}
+
+	var entries []notificationEntry
+	for _, n := range remote {
+		event := n.EventID
+		if n.EventName != "" && byName[strings.ToLower(n.EventName)] {
+			event = n.EventName
+		}
+		entries = append(entries, notificationEntry{
+			Event:            event,
+			NotificationType: n.NotificationType,
+			Parameter:        optionalString(n.Parameter),
+		})
+	}
+
+	// Keep the configured order for entries that still exist, then append the rest.
+	var ordered []notificationEntry
+	used := make([]bool, len(entries))
+	for _, p := range priorEntries {
+		for i, e := range entries {
+			if !used[i] && strings.EqualFold(e.Event, p.Event) &&
+				strings.EqualFold(e.NotificationType, p.NotificationType) &&
+				e.Parameter.ValueString() == p.Parameter.ValueString() {
+				ordered = append(ordered, e)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, e := range entries {
+		if !used[i] {
+			ordered = append(ordered, e)
+		}
+	}
+
+	if len(ordered) == 0 {
+		if prior.IsNull() {
+			return types.ListNull(notificationObjectType), diags
+		}
+		return types.ListValueMust(notificationObjectType, []attr.Value{}), diags
+	}
+
+	var values []attr.Value
+	for _, e := range ordered {
+		obj, d := types.ObjectValue(notificationObjectType.AttrTypes, map[string]attr.Value{
+			"event":             types.StringValue(e.Event),
+			"notification_type": types.StringValue(e.NotificationType),
+			"parameter":         e.Parameter,
+		})
+		diags.Append(d...)
+		values = append(values, obj)
+	}
+	listVal, d := types.ListValue(notificationObjectType, values)
+	diags.Append(d...)
+	return listVal, diags
+}
+
+func (r *NotificationSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notifications, diags := r.planNotifications(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueString()
+	}
+	if len(notifications) > 0 {
+		body["notificationSchemeEvents"] = buildNotificationEvents(notifications)
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post("/rest/api/3/notificationscheme", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating notification scheme", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NotificationSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, notifications, err := r.fetchNotifications(state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading notification scheme", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	if desc, ok := result["description"].(string); ok && desc != "" {
+		state.Description = types.StringValue(desc)
+	}
+
+	listVal, diags := notificationsToList(ctx, state.Notifications, notifications)
+	resp.Diagnostics.Append(diags...)
+	state.Notifications = listVal
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *NotificationSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wanted, diags := r.planNotifications(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueString()
+	}
+
+	schemeID := plan.ID.ValueString()
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/notificationscheme/%s", schemeID), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating notification scheme", err.Error())
+		return
+	}
+
+	// PUT only updates name and description; notifications are added and removed individually.
+	_, current, err := r.fetchNotifications(schemeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading notification scheme", err.Error())
+		return
+	}
+
+	wantedKeys := make(map[string]bool, len(wanted))
+	for _, n := range wanted {
+		wantedKeys[notificationKey(n.EventID, n.NotificationType, n.Parameter)] = true
+	}
+	currentKeys := make(map[string]bool, len(current))
+	for _, n := range current {
+		key := notificationKey(n.EventID, n.NotificationType, n.Parameter)
+		currentKeys[key] = true
+		if wantedKeys[key] {
+			continue
+		}
+		err := r.client.Delete(fmt.Sprintf("/rest/api/3/notificationscheme/%s/notification/%s", schemeID, n.ID))
+		if err != nil {
+			resp.Diagnostics.AddError("Error removing notification from scheme", err.Error())
+			return
+		}
+	}
+
+	var missing []remoteNotification
+	for _, n := range wanted {
+		if !currentKeys[notificationKey(n.EventID, n.NotificationType, n.Parameter)] {
+			missing = append(missing, n)
+		}
+	}
+	if len(missing) > 0 {
+		addBody := map[string]interface{}{
+			"notificationSchemeEvents": buildNotificationEvents(missing),
+		}
+		err := r.client.Put(fmt.Sprintf("/rest/api/3/notificationscheme/%s/notification", schemeID), addBody, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error adding notifications to scheme", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NotificationSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(fmt.Sprintf("/rest/api/3/notificationscheme/%s", state.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting notification scheme", err.Error())
+		return
+	}
+}
+
+func (r *NotificationSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	result, notifications, err := r.fetchNotifications(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing notification scheme", err.Error())
+		return
+	}
+
+	state := NotificationSchemeResourceModel{
+		ID:            types.StringValue(fmt.Sprintf("%v", result["id"])),
+		Name:          types.StringValue(fmt.Sprintf("%v", result["name"])),
+		Notifications: types.ListNull(notificationObjectType),
+	}
+	if desc, ok := result["description"].(string); ok && desc != "" {
+		state.Description = types.StringValue(desc)
+	}
+
+	listVal, diags := notificationsToList(ctx, state.Notifications, notifications)
+	resp.Diagnostics.Append(diags...)
+	state.Notifications = listVal
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,213 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PermissionGrantResource{}
+var _ resource.ResourceWithImportState = &PermissionGrantResource{}
+var _ resource.ResourceWithValidateConfig = &PermissionGrantResource{}
+var _ resource.ResourceWithModifyPlan = &PermissionGrantResource{}
+
+type PermissionGrantResource struct {
+	client *client.Client
+}
+
+type PermissionGrantResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	SchemeID        types.String `tfsdk:"scheme_id"`
+	Permission      types.String `tfsdk:"permission"`
+	HolderType      types.String `tfsdk:"holder_type"`
+	HolderParameter types.String `tfsdk:"holder_parameter"`
+}
+
+func NewPermissionGrantResource() resource.Resource {
+	return &PermissionGrantResource{}
+}
+
+func (r *PermissionGrantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_grant"
+}
+
+func (r *PermissionGrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single grant in a JIRA permission scheme, leaving the scheme's other grants untouched. " +
+			"Grants cannot be changed in place, so any change replaces the grant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The permission grant ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scheme_id": schema.StringAttribute{
+				Description: "The ID of the permission scheme.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission": schema.StringAttribute{
+				Description: "Permission key (e.g. BROWSE_PROJECTS, CREATE_ISSUES, ADMINISTER_PROJECTS).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"holder_type": schema.StringAttribute{
+				Description: "Holder type: group, projectRole, user, anyone, applicationRole, etc.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"holder_parameter": schema.StringAttribute{
+				Description: "Holder parameter: group name, role ID, account ID, etc. Leave empty for 'anyone'.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PermissionGrantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *PermissionGrantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PermissionGrantResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateHolderType(path.Root("holder_type"), config.HolderType, &resp.Diagnostics)
+}
+
+// ModifyPlan checks the permission key against the permissions of the site.
+func (r *PermissionGrantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan PermissionGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validatePermissionKey(r.client, path.Root("permission"), plan.Permission, &resp.Diagnostics)
+}
+
+func (r *PermissionGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PermissionGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := buildPermissionGrant(permissionGrant{
+		Permission:      plan.Permission,
+		HolderType:      plan.HolderType,
+		HolderParameter: plan.HolderParameter,
+	})
+
+	var result map[string]interface{}
+	err := r.client.Post(fmt.Sprintf("/rest/api/3/permissionscheme/%s/permission", plan.SchemeID.ValueString()), body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating permission grant", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PermissionGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PermissionGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/permissionscheme/%s/permission/%s", state.SchemeID.ValueString(), state.ID.ValueString()), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading permission grant", err.Error())
+		return
+	}
+
+	applyPermissionGrant(&state, result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// applyPermissionGrant copies a grant into the model. An empty holder parameter keeps the
+// prior spelling, so "" and null do not cause a diff.
+func applyPermissionGrant(state *PermissionGrantResourceModel, result map[string]interface{}) {
+	grant := parsePermissionGrant(result)
+	state.Permission = grant.Permission
+	state.HolderType = grant.HolderType
+	if !grant.HolderParameter.IsNull() || state.HolderParameter.ValueString() != "" {
+		state.HolderParameter = grant.HolderParameter
+	}
+}
+
+func (r *PermissionGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so Update is never called with changes.
+	var plan PermissionGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PermissionGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PermissionGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(fmt.Sprintf("/rest/api/3/permissionscheme/%s/permission/%s", state.SchemeID.ValueString(), state.ID.ValueString()))
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting permission grant", err.Error())
+		return
+	}
+}
+
+func (r *PermissionGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by "schemeId/grantId".
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format schemeId/grantId, got %q.", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scheme_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -3,9 +3,13 @@ package resources
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/david/terraform-provider-jira/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,6 +19,9 @@ import (
 
 var _ resource.Resource = &PermissionSchemeResource{}
 var _ resource.ResourceWithImportState = &PermissionSchemeResource{}
+var _ resource.ResourceWithUpgradeState = &PermissionSchemeResource{}
+var _ resource.ResourceWithValidateConfig = &PermissionSchemeResource{}
+var _ resource.ResourceWithModifyPlan = &PermissionSchemeResource{}
 
 type PermissionSchemeResource struct {
 	client *client.Client
@@ -24,9 +31,28 @@ type PermissionSchemeResourceModel struct {
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
-	Permissions types.List   `tfsdk:"permissions"`
+	Permissions types.Set    `tfsdk:"permissions"`
 }
 
+// permissionHolderTypes are the holder types JIRA accepts in permission grants.
+var permissionHolderTypes = []string{
+	"anyone", "applicationRole", "assignee", "group", "groupCustomField", "projectLead",
+	"projectRole", "reporter", "sd.customer.portal.only", "user", "userCustomField",
+}
+
+// permissionGrant is a single permission grant as configured in Terraform.
+type permissionGrant struct {
+	Permission      types.String `tfsdk:"permission"`
+	HolderType      types.String `tfsdk:"holder_type"`
+	HolderParameter types.String `tfsdk:"holder_parameter"`
+}
+
+// permissionsManagedKey is the private state key set once the permissions in state were
+// written from configuration or import. State migrated from version 0 lacks it: that state
+// holds every grant of the scheme, including JIRA's defaults, which must not be deleted just
+// because they are not configured.
+const permissionsManagedKey = "permissions_managed"
+
 var permissionGrantObjectType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
 		"permission":       types.StringType,
@@ -46,6 +72,7 @@ func (r *PermissionSchemeResource) Metadata(_ context.Context, req resource.Meta
 func (r *PermissionSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a JIRA permission scheme.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The permission scheme ID.",
@@ -62,8 +89,8 @@ func (r *PermissionSchemeResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "The permission scheme description.",
 				Optional:    true,
 			},
-			"permissions": schema.ListNestedAttribute{
-				Description: "List of permission grants.",
+			"permissions": schema.SetNestedAttribute{
+				Description: "Set of permission grants. Grants JIRA adds to the scheme on its own are not tracked.",
 				Optional:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -98,17 +125,110 @@ func (r *PermissionSchemeResource) Configure(_ context.Context, req resource.Con
 	r.client = c
 }
 
+func (r *PermissionSchemeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PermissionSchemeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Permissions.IsNull() || config.Permissions.IsUnknown() {
+		return
+	}
+
+	var grants []permissionGrant
+	resp.Diagnostics.Append(config.Permissions.ElementsAs(ctx, &grants, false)...)
+	for _, g := range grants {
+		validateHolderType(path.Root("permissions"), g.HolderType, &resp.Diagnostics)
+	}
+}
+
+// ModifyPlan checks permission keys against the permissions of the site, which include
+// those added by apps, so typos fail at plan time instead of during apply.
+func (r *PermissionSchemeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan PermissionSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Permissions.IsNull() || plan.Permissions.IsUnknown() {
+		return
+	}
+
+	var grants []permissionGrant
+	resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &grants, false)...)
+	for _, g := range grants {
+		validatePermissionKey(r.client, path.Root("permissions"), g.Permission, &resp.Diagnostics)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	managed, diags := req.Private.GetKey(ctx, permissionsManagedKey)
+	resp.Diagnostics.Append(diags...)
+	if managed != nil {
+		return
+	}
+	var state PermissionSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.Permissions.IsNull() {
+		return
+	}
+	if untracked := untrackedPermissionGrants(ctx, state.Permissions, plan.Permissions, &resp.Diagnostics); len(untracked) > 0 {
+		resp.Diagnostics.AddAttributeWarning(path.Root("permissions"), "Grants from migrated state are kept",
+			fmt.Sprintf("The state of this permission scheme was migrated from an earlier provider version and holds grants that are not in permissions: %s. "+
+				"They are removed from state on this apply but stay in JIRA. Delete them in JIRA or with a later apply if they are not wanted.", strings.Join(untracked, ", ")))
+	}
+}
+
+// untrackedPermissionGrants returns the keys of the grants in prior that are not in planned.
+func untrackedPermissionGrants(ctx context.Context, prior, planned types.Set, diags *diag.Diagnostics) []string {
+	var priorGrants, plannedGrants []permissionGrant
+	diags.Append(prior.ElementsAs(ctx, &priorGrants, false)...)
+	diags.Append(planned.ElementsAs(ctx, &plannedGrants, false)...)
+
+	plannedKeys := make(map[string]bool, len(plannedGrants))
+	for _, g := range plannedGrants {
+		plannedKeys[permissionGrantKey(g)] = true
+	}
+	var untracked []string
+	for _, g := range priorGrants {
+		if key := permissionGrantKey(g); !plannedKeys[key] {
+			untracked = append(untracked, key)
+		}
+	}
+	sort.Strings(untracked)
+	return untracked
+}
+
+func validateHolderType(attrPath path.Path, holderType types.String, diags *diag.Diagnostics) {
+	if holderType.IsNull() || holderType.IsUnknown() || containsString(permissionHolderTypes, holderType.ValueString()) {
+		return
+	}
+	diags.AddAttributeError(attrPath, "Invalid holder type",
+		fmt.Sprintf("holder_type %q is not valid. Valid values: %s.%s",
+			holderType.ValueString(), strings.Join(permissionHolderTypes, ", "), didYouMean(holderType.ValueString(), permissionHolderTypes)))
+}
+
+func validatePermissionKey(c *client.Client, attrPath path.Path, permission types.String, diags *diag.Diagnostics) {
+	if permission.IsNull() || permission.IsUnknown() {
+		return
+	}
+	keys, err := c.ProjectPermissionKeys()
+	if err != nil {
+		diags.AddAttributeWarning(attrPath, "Could not validate permission keys", err.Error())
+		return
+	}
+	if containsString(keys, permission.ValueString()) {
+		return
+	}
+	diags.AddAttributeError(attrPath, "Unknown permission",
+		fmt.Sprintf("Permission %q does not exist on this JIRA site.%s", permission.ValueString(), didYouMean(permission.ValueString(), keys)))
+}
+
 func (r *PermissionSchemeResource) buildPermissions(ctx context.Context, plan PermissionSchemeResourceModel) ([]map[string]interface{}, error) {
 	if plan.Permissions.IsNull() || plan.Permissions.IsUnknown() {
 		return nil, nil
 	}
 
-	var perms []struct {
-		Permission      string `tfsdk:"permission"`
-		HolderType      string `tfsdk:"holder_type"`
-		HolderParameter string `tfsdk:"holder_parameter"`
-	}
-
+	var perms []permissionGrant
 	diags := plan.Permissions.ElementsAs(ctx, &perms, false)
 	if diags.HasError() {
 		return nil, fmt.Errorf("error reading permissions")
@@ -116,18 +236,100 @@ func (r *PermissionSchemeResource) buildPermissions(ctx context.Context, plan Pe
 
 	var result []map[string]interface{}
 	for _, p := range perms {
-		grant := map[string]interface{}{
-			"permission": p.Permission,
-			"holder": map[string]interface{}{
-				"type":      p.HolderType,
-				"parameter": p.HolderParameter,
-			},
-		}
-		result = append(result, grant)
+		result = append(result, buildPermissionGrant(p))
 	}
 	return result, nil
 }
 
+func buildPermissionGrant(p permissionGrant) map[string]interface{} {
+	holder := map[string]interface{}{"type": p.HolderType.ValueString()}
+	if p.HolderParameter.ValueString() != "" {
+		holder["parameter"] = p.HolderParameter.ValueString()
+	}
+	return map[string]interface{}{
+		"permission": p.Permission.ValueString(),
+		"holder":     holder,
+	}
+}
+
+// remotePermissionGrants parses the grants of a scheme read with expand=permissions, mapping
+// the empty holder parameter JIRA returns for holders like anyone to null.
+func remotePermissionGrants(result map[string]interface{}) []permissionGrant {
+	var grants []permissionGrant
+	permsRaw, _ := result["permissions"].([]interface{})
+	for _, p := range permsRaw {
+		pMap, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		grants = append(grants, parsePermissionGrant(pMap))
+	}
+	return grants
+}
+
+func parsePermissionGrant(pMap map[string]interface{}) permissionGrant {
+	grant := permissionGrant{
+		Permission:      types.StringValue(fmt.Sprintf("%v", pMap["permission"])),
+		HolderType:      types.StringValue(""),
+		HolderParameter: types.StringNull(),
+	}
+	if holder, ok := pMap["holder"].(map[string]interface{}); ok {
+		grant.HolderType = types.StringValue(fmt.Sprintf("%v", holder["type"]))
+		if param, ok := holder["parameter"]; ok && param != nil && fmt.Sprintf("%v", param) != "" {
+			grant.HolderParameter = types.StringValue(fmt.Sprintf("%v", param))
+		}
+	}
+	return grant
+}
+
+// permissionGrantKey identifies a grant regardless of how an empty holder parameter is spelled.
+func permissionGrantKey(g permissionGrant) string {
+	return g.Permission.ValueString() + "|" + strings.ToLower(g.HolderType.ValueString()) + "|" + g.HolderParameter.ValueString()
+}
+
+// permissionGrantsToSet returns the grants to store in state. With all set, every remote
+// grant is returned (used on import). Otherwise only the grants already in prior are kept,
+// as written there, so default grants JIRA adds do not show up as changes while removed
+// grants do.
+func permissionGrantsToSet(ctx context.Context, prior types.Set, remote []permissionGrant, all bool) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	remoteKeys := make(map[string]bool, len(remote))
+	for _, g := range remote {
+		remoteKeys[permissionGrantKey(g)] = true
+	}
+
+	var grants []permissionGrant
+	if all {
+		grants = remote
+	} else {
+		if prior.IsNull() || prior.IsUnknown() {
+			return prior, diags
+		}
+		var priorGrants []permissionGrant
+		diags.Append(prior.ElementsAs(ctx, &priorGrants, false)...)
+		for _, g := range priorGrants {
+			if remoteKeys[permissionGrantKey(g)] {
+				grants = append(grants, g)
+			}
+		}
+	}
+
+	values := []attr.Value{}
+	for _, g := range grants {
+		obj, d := types.ObjectValue(permissionGrantObjectType.AttrTypes, map[string]attr.Value{
+			"permission":       g.Permission,
+			"holder_type":      g.HolderType,
+			"holder_parameter": g.HolderParameter,
+		})
+		diags.Append(d...)
+		values = append(values, obj)
+	}
+	set, d := types.SetValue(permissionGrantObjectType, values)
+	diags.Append(d...)
+	return set, diags
+}
+
 func (r *PermissionSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan PermissionSchemeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -160,6 +362,7 @@ func (r *PermissionSchemeResource) Create(ctx context.Context, req resource.Crea
 
 	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, permissionsManagedKey, []byte("true"))...)
 }
 
 func (r *PermissionSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -185,49 +388,24 @@ func (r *PermissionSchemeResource) Read(ctx context.Context, req resource.ReadRe
 		state.Description = types.StringValue(desc)
 	}
 
-	// Parse permissions from response
-	if permsRaw, ok := result["permissions"].([]interface{}); ok && len(permsRaw) > 0 {
-		var permValues []attr.Value
-		for _, p := range permsRaw {
-			pMap := p.(map[string]interface{})
-			permission := fmt.Sprintf("%v", pMap["permission"])
-
-			holderType := ""
-			holderParam := ""
-			if holder, ok := pMap["holder"].(map[string]interface{}); ok {
-				holderType = fmt.Sprintf("%v", holder["type"])
-				if param, ok := holder["parameter"]; ok && param != nil {
-					holderParam = fmt.Sprintf("%v", param)
-				}
-			}
-
-			objVal, diags := types.ObjectValue(
-				permissionGrantObjectType.AttrTypes,
-				map[string]attr.Value{
-					"permission":       types.StringValue(permission),
-					"holder_type":      types.StringValue(holderType),
-					"holder_parameter": types.StringValue(holderParam),
-				},
-			)
-			resp.Diagnostics.Append(diags...)
-			permValues = append(permValues, objVal)
-		}
-
-		listVal, diags := types.ListValue(permissionGrantObjectType, permValues)
-		resp.Diagnostics.Append(diags...)
-		state.Permissions = listVal
-	}
+	permissions, diags := permissionGrantsToSet(ctx, state.Permissions, remotePermissionGrants(result), false)
+	resp.Diagnostics.Append(diags...)
+	state.Permissions = permissions
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, permissionsManagedKey, []byte("true"))...)
 }
 
 func (r *PermissionSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan PermissionSchemeResourceModel
+	var plan, state PermissionSchemeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The permissions are left out of the PUT: JIRA would replace every grant of the scheme
+	// with them, including grants that are not managed here.
 	body := map[string]interface{}{
 		"name": plan.Name.ValueString(),
 	}
@@ -235,22 +413,113 @@ func (r *PermissionSchemeResource) Update(ctx context.Context, req resource.Upda
 		body["description"] = plan.Description.ValueString()
 	}
 
-	perms, err := r.buildPermissions(ctx, plan)
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/permissionscheme/%s", plan.ID.ValueString()), body, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error building permissions", err.Error())
+		resp.Diagnostics.AddError("Error updating permission scheme", err.Error())
 		return
 	}
-	if perms != nil {
-		body["permissions"] = perms
+
+	// Grants in state migrated from version 0 that are not configured were never managed
+	// here, so they are only dropped from state.
+	prior := state.Permissions
+	managed, diags := req.Private.GetKey(ctx, permissionsManagedKey)
+	resp.Diagnostics.Append(diags...)
+	if managed == nil {
+		prior = types.SetNull(permissionGrantObjectType)
 	}
 
-	err = r.client.Put(fmt.Sprintf("/rest/api/3/permissionscheme/%s", plan.ID.ValueString()), body, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating permission scheme", err.Error())
+	resp.Diagnostics.Append(r.syncPermissionGrants(ctx, plan.ID.ValueString(), prior, plan.Permissions)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if !plan.Permissions.IsNull() {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, permissionsManagedKey, []byte("true"))...)
+	}
+}
+
+// syncPermissionGrants deletes the grants that were removed from prior and adds the grants
+// that are new in planned, one grant at a time. Grants that are in neither are left alone.
+// A null planned set stops managing the grants without deleting them.
+func (r *PermissionSchemeResource) syncPermissionGrants(ctx context.Context, schemeID string, prior, planned types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if planned.IsNull() || planned.IsUnknown() {
+		return diags
+	}
+
+	var priorGrants, plannedGrants []permissionGrant
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorGrants, false)...)
+	}
+	diags.Append(planned.ElementsAs(ctx, &plannedGrants, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	priorKeys := make(map[string]bool, len(priorGrants))
+	for _, g := range priorGrants {
+		priorKeys[permissionGrantKey(g)] = true
+	}
+	plannedKeys := make(map[string]bool, len(plannedGrants))
+	for _, g := range plannedGrants {
+		plannedKeys[permissionGrantKey(g)] = true
+	}
+
+	removed := map[string]bool{}
+	for key := range priorKeys {
+		if !plannedKeys[key] {
+			removed[key] = true
+		}
+	}
+	var added []permissionGrant
+	for _, g := range plannedGrants {
+		if !priorKeys[permissionGrantKey(g)] {
+			added = append(added, g)
+		}
+	}
+	if len(removed) == 0 && len(added) == 0 {
+		return diags
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/permissionscheme/%s?expand=permissions", schemeID), &result)
+	if err != nil {
+		diags.AddError("Error reading permission scheme", err.Error())
+		return diags
+	}
+
+	remoteKeys := map[string]bool{}
+	permsRaw, _ := result["permissions"].([]interface{})
+	for _, p := range permsRaw {
+		pMap, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key := permissionGrantKey(parsePermissionGrant(pMap))
+		remoteKeys[key] = true
+		if !removed[key] {
+			continue
+		}
+		err := r.client.Delete(fmt.Sprintf("/rest/api/3/permissionscheme/%s/permission/%v", schemeID, pMap["id"]))
+		if err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error deleting permission grant", err.Error())
+			return diags
+		}
+	}
+
+	for _, g := range added {
+		if remoteKeys[permissionGrantKey(g)] {
+			continue
+		}
+		err := r.client.Post(fmt.Sprintf("/rest/api/3/permissionscheme/%s/permission", schemeID), buildPermissionGrant(g), nil)
+		if err != nil {
+			diags.AddError("Error creating permission grant", err.Error())
+			return diags
+		}
+	}
+
+	return diags
 }
 
 func (r *PermissionSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -276,12 +545,82 @@ func (r *PermissionSchemeResource) ImportState(ctx context.Context, req resource
 	}
 
 	state := PermissionSchemeResourceModel{
-		ID:   types.StringValue(fmt.Sprintf("%v", result["id"])),
-		Name: types.StringValue(fmt.Sprintf("%v", result["name"])),
+		ID:          types.StringValue(fmt.Sprintf("%v", result["id"])),
+		Name:        types.StringValue(fmt.Sprintf("%v", result["name"])),
+		Permissions: types.SetNull(permissionGrantObjectType),
 	}
 	if desc, ok := result["description"].(string); ok && desc != "" {
 		state.Description = types.StringValue(desc)
 	}
 
+	// Import every grant, so the first plan does not remove grants that exist in JIRA.
+	permissions, diags := permissionGrantsToSet(ctx, state.Permissions, remotePermissionGrants(result), true)
+	resp.Diagnostics.Append(diags...)
+	state.Permissions = permissions
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
+
+// UpgradeState migrates state written while permissions was a list. Version 1 stores the
+// grants as a set, with null instead of "" for holders without a parameter. Version 0 state
+// holds every grant of the scheme, so the migrated state lacks permissionsManagedKey until
+// the next apply writes the configured grants.
+func (r *PermissionSchemeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":          schema.StringAttribute{Computed: true},
+					"name":        schema.StringAttribute{Required: true},
+					"description": schema.StringAttribute{Optional: true},
+					"permissions": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"permission":       schema.StringAttribute{Required: true},
+								"holder_type":      schema.StringAttribute{Required: true},
+								"holder_parameter": schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior struct {
+					ID          types.String `tfsdk:"id"`
+					Name        types.String `tfsdk:"name"`
+					Description types.String `tfsdk:"description"`
+					Permissions types.List   `tfsdk:"permissions"`
+				}
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := PermissionSchemeResourceModel{
+					ID:          prior.ID,
+					Name:        prior.Name,
+					Description: prior.Description,
+					Permissions: types.SetNull(permissionGrantObjectType),
+				}
+				if !prior.Permissions.IsNull() {
+					var grants []permissionGrant
+					resp.Diagnostics.Append(prior.Permissions.ElementsAs(ctx, &grants, false)...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+					for i := range grants {
+						if grants[i].HolderParameter.ValueString() == "" {
+							grants[i].HolderParameter = types.StringNull()
+						}
+					}
+					permissions, diags := permissionGrantsToSet(ctx, types.SetNull(permissionGrantObjectType), grants, true)
+					resp.Diagnostics.Append(diags...)
+					state.Permissions = permissions
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,216 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PriorityResource{}
+var _ resource.ResourceWithImportState = &PriorityResource{}
+
+type PriorityResource struct {
+	client *client.Client
+}
+
+type PriorityResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	IconURL     types.String `tfsdk:"icon_url"`
+}
+
+func NewPriorityResource() resource.Resource {
+	return &PriorityResource{}
+}
+
+func (r *PriorityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_priority"
+}
+
+func (r *PriorityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA issue priority.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The priority ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The priority name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The priority description.",
+				Optional:    true,
+			},
+			"color": schema.StringAttribute{
+				Description: "The status color of the priority in 3-digit or 6-digit hexadecimal format (e.g. #FF0000).",
+				Required:    true,
+			},
+			"icon_url": schema.StringAttribute{
+				Description: "The URL of the priority icon. Must be one of JIRA's built-in priority icons (e.g. /images/icons/priorities/major.png). Defaults to the icon chosen by JIRA.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PriorityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *PriorityResource) buildBody(plan PriorityResourceModel) map[string]interface{} {
+	body := map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"statusColor": plan.Color.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueString()
+	}
+	if !plan.IconURL.IsNull() && !plan.IconURL.IsUnknown() {
+		body["iconUrl"] = plan.IconURL.ValueString()
+	}
+	return body
+}
+
+func (r *PriorityResource) applyResult(state *PriorityResourceModel, result map[string]interface{}) {
+	state.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	state.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	if desc, ok := result["description"].(string); ok && desc != "" {
+		state.Description = types.StringValue(desc)
+	}
+	if color, ok := result["statusColor"].(string); ok {
+		state.Color = types.StringValue(color)
+	}
+	if icon, ok := result["iconUrl"].(string); ok {
+		state.IconURL = types.StringValue(icon)
+	}
+}
+
+func (r *PriorityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PriorityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post("/rest/api/3/priority", r.buildBody(plan), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating priority", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+
+	// Read back the icon JIRA assigned when none was given.
+	if plan.IconURL.IsUnknown() {
+		var priority map[string]interface{}
+		if err := r.client.Get(fmt.Sprintf("/rest/api/3/priority/%s", plan.ID.ValueString()), &priority); err != nil {
+			resp.Diagnostics.AddError("Error reading priority", err.Error())
+			return
+		}
+		if icon, ok := priority["iconUrl"].(string); ok {
+			plan.IconURL = types.StringValue(icon)
+		} else {
+			plan.IconURL = types.StringNull()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PriorityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PriorityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/priority/%s", state.ID.ValueString()), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading priority", err.Error())
+		return
+	}
+
+	r.applyResult(&state, result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *PriorityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PriorityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/priority/%s", plan.ID.ValueString()), r.buildBody(plan), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating priority", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PriorityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PriorityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting a priority runs as an async task; issues are remapped by the priority schemes.
+	var result map[string]interface{}
+	err := r.client.DeleteWithResult(fmt.Sprintf("/rest/api/3/priority/%s", state.ID.ValueString()), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting priority", err.Error())
+		return
+	}
+	if taskID := client.TaskIDFromResponse(result); taskID != "" {
+		if err := r.client.WaitForTask(taskID); err != nil {
+			resp.Diagnostics.AddError("Error deleting priority", err.Error())
+			return
+		}
+	}
+}
+
+func (r *PriorityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/priority/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing priority", err.Error())
+		return
+	}
+
+	var state PriorityResourceModel
+	r.applyResult(&state, result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,535 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PrioritySchemeResource{}
+var _ resource.ResourceWithImportState = &PrioritySchemeResource{}
+var _ resource.ResourceWithUpgradeState = &PrioritySchemeResource{}
+
+type PrioritySchemeResource struct {
+	client *client.Client
+}
+
+type PrioritySchemeResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Description             types.String `tfsdk:"description"`
+	DefaultPriorityID       types.String `tfsdk:"default_priority_id"`
+	PriorityIDs             types.Set    `tfsdk:"priority_ids"`
+	ProjectIDs              types.Set    `tfsdk:"project_ids"`
+	ProjectPriorityMappings types.Map    `tfsdk:"project_priority_mappings"`
+	RemovedPriorityMappings types.Map    `tfsdk:"removed_priority_mappings"`
+}
+
+func NewPrioritySchemeResource() resource.Resource {
+	return &PrioritySchemeResource{}
+}
+
+func (r *PrioritySchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_priority_scheme"
+}
+
+func (r *PrioritySchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA priority scheme and the projects that use it.",
+		Version:     1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The priority scheme ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The priority scheme name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The priority scheme description.",
+				Optional:    true,
+			},
+			"default_priority_id": schema.StringAttribute{
+				Description: "The ID of the default priority. Must be one of priority_ids.",
+				Required:    true,
+			},
+			"priority_ids": schema.SetAttribute{
+				Description: "IDs of the priorities in this scheme. JIRA always displays them in the global priority order.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"project_ids": schema.SetAttribute{
+				Description: "IDs of the projects that use this priority scheme.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"project_priority_mappings": schema.MapAttribute{
+				Description: "Map of priority IDs used by newly associated projects to priority IDs in this scheme. Required when those projects use priorities missing from the scheme.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"removed_priority_mappings": schema.MapAttribute{
+				Description: "Map of priority IDs removed from this scheme to the priority IDs that issues should be moved to. Also used when projects leave the scheme, including on destroy.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *PrioritySchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+// parseIDs converts string IDs to the int64 values the priority scheme API expects.
+func parseIDs(ids []string) ([]int64, error) {
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		n, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("ID '%s' must be numeric", id)
+		}
+		result = append(result, n)
+	}
+	return result, nil
+}
+
+// parseIDMapping converts a map of string IDs to the int64 mapping the priority scheme API expects.
+func parseIDMapping(ctx context.Context, m types.Map) (map[string]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if m.IsNull() || m.IsUnknown() {
+		return map[string]int64{}, diags
+	}
+	raw := make(map[string]string)
+	diags.Append(m.ElementsAs(ctx, &raw, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	result := make(map[string]int64, len(raw))
+	for k, v := range raw {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			diags.AddError("Invalid priority mapping", fmt.Sprintf("Priority ID '%s' must be numeric.", v))
+			return nil, diags
+		}
+		result[k] = n
+	}
+	return result, diags
+}
+
+func (r *PrioritySchemeResource) waitForSchemeTask(result map[string]interface{}) error {
+	task, ok := result["task"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if taskID := client.TaskIDFromResponse(task); taskID != "" {
+		return r.client.WaitForTask(taskID)
+	}
+	return nil
+}
+
+// fetchScheme returns the scheme details together with all its priority and project IDs.
+func (r *PrioritySchemeResource) fetchScheme(id string) (map[string]interface{}, []string, []string, error) {
+	schemes, err := r.client.GetAllPages("/rest/api/3/priorityscheme", url.Values{"schemeId": {id}})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var scheme map[string]interface{}
+	for _, s := range schemes {
+		if fmt.Sprintf("%v", s["id"]) == id {
+			scheme = s
+			break
+		}
+	}
+	if scheme == nil {
+		return nil, nil, nil, &client.APIError{StatusCode: 404, ErrorMessages: []string{fmt.Sprintf("priority scheme %s not found", id)}}
+	}
+
+	priorities, err := r.client.GetAllPages(fmt.Sprintf("/rest/api/3/priorityscheme/%s/priorities", id), nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var priorityIDs []string
+	for _, p := range priorities {
+		priorityIDs = append(priorityIDs, fmt.Sprintf("%v", p["id"]))
+		if isDefault, ok := p["isDefault"].(bool); ok && isDefault {
+			if _, ok := scheme["defaultPriorityId"]; !ok {
+				scheme["defaultPriorityId"] = p["id"]
+			}
+		}
+	}
+
+	projects, err := r.client.GetAllPages(fmt.Sprintf("/rest/api/3/priorityscheme/%s/projects", id), nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var projectIDs []string
+	for _, p := range projects {
+		projectIDs = append(projectIDs, fmt.Sprintf("%v", p["id"]))
+	}
+
+	return scheme, priorityIDs, projectIDs, nil
+}
+
+// applyPriorityScheme copies the remote scheme into the model.
+func applyPriorityScheme(ctx context.Context, model *PrioritySchemeResourceModel, scheme map[string]interface{}, priorityIDs, projectIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(fmt.Sprintf("%v", scheme["id"]))
+	model.Name = types.StringValue(fmt.Sprintf("%v", scheme["name"]))
+	if desc, ok := scheme["description"].(string); ok && desc != "" {
+		model.Description = types.StringValue(desc)
+	}
+	if def, ok := scheme["defaultPriorityId"]; ok && def != nil {
+		model.DefaultPriorityID = types.StringValue(fmt.Sprintf("%v", def))
+	}
+
+	priorityVal, d := types.SetValueFrom(ctx, types.StringType, priorityIDs)
+	diags.Append(d...)
+	model.PriorityIDs = priorityVal
+
+	if len(projectIDs) > 0 || !model.ProjectIDs.IsNull() {
+		setVal, d := types.SetValueFrom(ctx, types.StringType, projectIDs)
+		diags.Append(d...)
+		model.ProjectIDs = setVal
+	}
+
+	return diags
+}
+
+func (r *PrioritySchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PrioritySchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorityIDs, projectIDs []string
+	resp.Diagnostics.Append(plan.PriorityIDs.ElementsAs(ctx, &priorityIDs, false)...)
+	if !plan.ProjectIDs.IsNull() && !plan.ProjectIDs.IsUnknown() {
+		resp.Diagnostics.Append(plan.ProjectIDs.ElementsAs(ctx, &projectIDs, false)...)
+	}
+	mappingsIn, diags := parseIDMapping(ctx, plan.ProjectPriorityMappings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorities, err := parseIDs(priorityIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid priority_ids", err.Error())
+		return
+	}
+	projects, err := parseIDs(projectIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project_ids", err.Error())
+		return
+	}
+	defaultID, err := strconv.ParseInt(plan.DefaultPriorityID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid default_priority_id", "Priority ID must be numeric.")
+		return
+	}
+
+	body := map[string]interface{}{
+		"name":              plan.Name.ValueString(),
+		"defaultPriorityId": defaultID,
+		"priorityIds":       priorities,
+		"projectIds":        projects,
+		"mappings": map[string]interface{}{
+			"in":  mappingsIn,
+			"out": map[string]int64{},
+		},
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueString()
+	}
+
+	var result map[string]interface{}
+	err = r.client.Post("/rest/api/3/priorityscheme", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating priority scheme", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+
+	if err := r.waitForSchemeTask(result); err != nil {
+		resp.Diagnostics.AddError("Error associating projects with priority scheme", err.Error())
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PrioritySchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PrioritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheme, priorityIDs, projectIDs, err := r.fetchScheme(state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading priority scheme", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(applyPriorityScheme(ctx, &state, scheme, priorityIDs, projectIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *PrioritySchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PrioritySchemeResourceModel
+	var state PrioritySchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var wantedPriorities, currentPriorities, wantedProjects, currentProjects []string
+	resp.Diagnostics.Append(plan.PriorityIDs.ElementsAs(ctx, &wantedPriorities, false)...)
+	resp.Diagnostics.Append(state.PriorityIDs.ElementsAs(ctx, &currentPriorities, false)...)
+	if !plan.ProjectIDs.IsNull() && !plan.ProjectIDs.IsUnknown() {
+		resp.Diagnostics.Append(plan.ProjectIDs.ElementsAs(ctx, &wantedProjects, false)...)
+	}
+	if !state.ProjectIDs.IsNull() {
+		resp.Diagnostics.Append(state.ProjectIDs.ElementsAs(ctx, &currentProjects, false)...)
+	}
+	mappingsIn, diags := parseIDMapping(ctx, plan.ProjectPriorityMappings)
+	resp.Diagnostics.Append(diags...)
+	mappingsOut, diags := parseIDMapping(ctx, plan.RemovedPriorityMappings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addPriorities, err := parseIDs(stringsMissingFrom(wantedPriorities, currentPriorities))
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid priority_ids", err.Error())
+		return
+	}
+	removePriorities, err := parseIDs(stringsMissingFrom(currentPriorities, wantedPriorities))
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid priority_ids", err.Error())
+		return
+	}
+	addProjects, err := parseIDs(stringsMissingFrom(wantedProjects, currentProjects))
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project_ids", err.Error())
+		return
+	}
+	removeProjects, err := parseIDs(stringsMissingFrom(currentProjects, wantedProjects))
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project_ids", err.Error())
+		return
+	}
+	defaultID, err := strconv.ParseInt(plan.DefaultPriorityID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid default_priority_id", "Priority ID must be numeric.")
+		return
+	}
+
+	body := map[string]interface{}{
+		"name":              plan.Name.ValueString(),
+		"defaultPriorityId": defaultID,
+		"priorities": map[string]interface{}{
+			"add":    map[string]interface{}{"ids": addPriorities},
+			"remove": map[string]interface{}{"ids": removePriorities},
+		},
+		"projects": map[string]interface{}{
+			"add":    map[string]interface{}{"ids": addProjects},
+			"remove": map[string]interface{}{"ids": removeProjects},
+		},
+		"mappings": map[string]interface{}{
+			"in":  mappingsIn,
+			"out": mappingsOut,
+		},
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueString()
+	}
+
+	var result map[string]interface{}
+	err = r.client.Put(fmt.Sprintf("/rest/api/3/priorityscheme/%s", plan.ID.ValueString()), body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating priority scheme", err.Error())
+		return
+	}
+	if err := r.waitForSchemeTask(result); err != nil {
+		resp.Diagnostics.AddError("Error updating priority scheme", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// stringsMissingFrom returns the elements of a that are not in b.
+func stringsMissingFrom(a, b []string) []string {
+	present := make(map[string]bool, len(b))
+	for _, s := range b {
+		present[s] = true
+	}
+	var missing []string
+	for _, s := range a {
+		if !present[s] {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
+
+func (r *PrioritySchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PrioritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// JIRA refuses to delete a scheme that projects still use, so move them back to the
+	// default scheme first.
+	_, _, projectIDs, err := r.fetchScheme(state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading priority scheme", err.Error())
+		return
+	}
+	if len(projectIDs) > 0 {
+		projects, err := parseIDs(projectIDs)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid project_ids", err.Error())
+			return
+		}
+		mappingsOut, diags := parseIDMapping(ctx, state.RemovedPriorityMappings)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		body := map[string]interface{}{
+			"projects": map[string]interface{}{
+				"remove": map[string]interface{}{"ids": projects},
+			},
+			"mappings": map[string]interface{}{
+				"in":  map[string]int64{},
+				"out": mappingsOut,
+			},
+		}
+		var result map[string]interface{}
+		err = r.client.Put(fmt.Sprintf("/rest/api/3/priorityscheme/%s", state.ID.ValueString()), body, &result)
+		if err == nil {
+			err = r.waitForSchemeTask(result)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error removing projects from priority scheme", err.Error())
+			return
+		}
+	}
+
+	err = r.client.Delete(fmt.Sprintf("/rest/api/3/priorityscheme/%s", state.ID.ValueString()))
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting priority scheme", err.Error())
+		return
+	}
+}
+
+func (r *PrioritySchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scheme, priorityIDs, projectIDs, err := r.fetchScheme(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing priority scheme", err.Error())
+		return
+	}
+
+	state := PrioritySchemeResourceModel{
+		PriorityIDs:             types.SetNull(types.StringType),
+		ProjectIDs:              types.SetNull(types.StringType),
+		ProjectPriorityMappings: types.MapNull(types.StringType),
+		RemovedPriorityMappings: types.MapNull(types.StringType),
+	}
+	resp.Diagnostics.Append(applyPriorityScheme(ctx, &state, scheme, priorityIDs, projectIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// UpgradeState migrates state written while priority_ids was a list. JIRA ignores the order
+// of the priorities, so version 1 stores them as a set.
+func (r *PrioritySchemeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                        schema.StringAttribute{Computed: true},
+					"name":                      schema.StringAttribute{Required: true},
+					"description":               schema.StringAttribute{Optional: true},
+					"default_priority_id":       schema.StringAttribute{Required: true},
+					"priority_ids":              schema.ListAttribute{Required: true, ElementType: types.StringType},
+					"project_ids":               schema.SetAttribute{Optional: true, ElementType: types.StringType},
+					"project_priority_mappings": schema.MapAttribute{Optional: true, ElementType: types.StringType},
+					"removed_priority_mappings": schema.MapAttribute{Optional: true, ElementType: types.StringType},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior struct {
+					ID                      types.String `tfsdk:"id"`
+					Name                    types.String `tfsdk:"name"`
+					Description             types.String `tfsdk:"description"`
+					DefaultPriorityID       types.String `tfsdk:"default_priority_id"`
+					PriorityIDs             types.List   `tfsdk:"priority_ids"`
+					ProjectIDs              types.Set    `tfsdk:"project_ids"`
+					ProjectPriorityMappings types.Map    `tfsdk:"project_priority_mappings"`
+					RemovedPriorityMappings types.Map    `tfsdk:"removed_priority_mappings"`
+				}
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := PrioritySchemeResourceModel{
+					ID:                      prior.ID,
+					Name:                    prior.Name,
+					Description:             prior.Description,
+					DefaultPriorityID:       prior.DefaultPriorityID,
+					PriorityIDs:             types.SetNull(types.StringType),
+					ProjectIDs:              prior.ProjectIDs,
+					ProjectPriorityMappings: prior.ProjectPriorityMappings,
+					RemovedPriorityMappings: prior.RemovedPriorityMappings,
+				}
+				if !prior.PriorityIDs.IsNull() {
+					var ids []string
+					resp.Diagnostics.Append(prior.PriorityIDs.ElementsAs(ctx, &ids, false)...)
+					priorityIDs, diags := types.SetValueFrom(ctx, types.StringType, ids)
+					resp.Diagnostics.Append(diags...)
+					state.PriorityIDs = priorityIDs
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
@@ -22,16 +22,19 @@ type ProjectResource struct {
 }
 
 type ProjectResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Key                 types.String `tfsdk:"key"`
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	ProjectTypeKey      types.String `tfsdk:"project_type_key"`
-	LeadAccountID       types.String `tfsdk:"lead_account_id"`
-	AssigneeType        types.String `tfsdk:"assignee_type"`
-	IssueTypeSchemeID   types.String `tfsdk:"issue_type_scheme_id"`
-	PermissionSchemeID  types.String `tfsdk:"permission_scheme_id"`
-	WorkflowSchemeID    types.String `tfsdk:"workflow_scheme_id"`
+	ID                    types.String `tfsdk:"id"`
+	Key                   types.String `tfsdk:"key"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	ProjectTypeKey        types.String `tfsdk:"project_type_key"`
+	LeadAccountID         types.String `tfsdk:"lead_account_id"`
+	AssigneeType          types.String `tfsdk:"assignee_type"`
+	IssueTypeSchemeID     types.String `tfsdk:"issue_type_scheme_id"`
+	PermissionSchemeID    types.String `tfsdk:"permission_scheme_id"`
+	WorkflowSchemeID      types.String `tfsdk:"workflow_scheme_id"`
+	NotificationSchemeID  types.String `tfsdk:"notification_scheme_id"`
+	IssueSecuritySchemeID types.String `tfsdk:"issue_security_scheme_id"`
+	CategoryID            types.String `tfsdk:"category_id"`
 }
 
 func NewProjectResource() resource.Resource {
@@ -89,6 +92,18 @@ func (r *ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Workflow scheme ID. Use the ID from jira_workflow_scheme.",
 				Optional:    true,
 			},
+			"notification_scheme_id": schema.StringAttribute{
+				Description: "Notification scheme ID. Use the ID from jira_notification_scheme.",
+				Optional:    true,
+			},
+			"issue_security_scheme_id": schema.StringAttribute{
+				Description: "Issue security scheme ID. Use the ID from jira_issue_security_scheme.",
+				Optional:    true,
+			},
+			"category_id": schema.StringAttribute{
+				Description: "Project category ID. Use the ID from jira_project_category.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -152,6 +167,33 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 		body["workflowScheme"] = id
 	}
+	if !plan.NotificationSchemeID.IsNull() && !plan.NotificationSchemeID.IsUnknown() {
+		id, err := strconv.ParseInt(plan.NotificationSchemeID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("notification_scheme_id"), "Invalid notification scheme ID",
+				"Scheme ID must be a numeric string (e.g. from jira_notification_scheme.id).")
+			return
+		}
+		body["notificationScheme"] = id
+	}
+	if !plan.IssueSecuritySchemeID.IsNull() && !plan.IssueSecuritySchemeID.IsUnknown() {
+		id, err := strconv.ParseInt(plan.IssueSecuritySchemeID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("issue_security_scheme_id"), "Invalid issue security scheme ID",
+				"Scheme ID must be a numeric string (e.g. from jira_issue_security_scheme.id).")
+			return
+		}
+		body["issueSecurityScheme"] = id
+	}
+	if !plan.CategoryID.IsNull() && !plan.CategoryID.IsUnknown() {
+		id, err := strconv.ParseInt(plan.CategoryID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("category_id"), "Invalid project category ID",
+				"Category ID must be a numeric string (e.g. from jira_project_category.id).")
+			return
+		}
+		body["categoryId"] = id
+	}
 
 	var result map[string]interface{}
 	err := r.client.Post("/rest/api/3/project", body, &result)
@@ -204,6 +246,30 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if id := schemeIDFromResponse(result, "workflowScheme"); id != "" {
 		state.WorkflowSchemeID = types.StringValue(id)
 	}
+	// Removing category_id leaves the category in place, so only track it when it is managed here.
+	// A managed category removed outside Terraform is read as null, so the plan sets it again.
+	if !state.CategoryID.IsNull() {
+		state.CategoryID = optionalString(schemeIDFromResponse(result, "projectCategory"))
+	}
+	// Every project has a notification scheme; only track it when it is managed here.
+	if !state.NotificationSchemeID.IsNull() {
+		id, err := r.notificationSchemeID(state.Key.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading project notification scheme", err.Error())
+			return
+		}
+		if id != "" {
+			state.NotificationSchemeID = types.StringValue(id)
+		}
+	}
+	if !state.IssueSecuritySchemeID.IsNull() {
+		id, err := r.issueSecuritySchemeID(state.Key.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading project issue security scheme", err.Error())
+			return
+		}
+		state.IssueSecuritySchemeID = optionalString(id)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -224,9 +290,45 @@ func schemeIDFromResponse(result map[string]interface{}, key string) string {
 	return fmt.Sprintf("%v", v)
 }
 
+// notificationSchemeID returns the ID of the notification scheme assigned to the project.
+// The GET project response does not include it, so it is read from the dedicated endpoint.
+func (r *ProjectResource) notificationSchemeID(projectKey string) (string, error) {
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/project/%s/notificationscheme", projectKey), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if id, ok := result["id"]; ok && id != nil {
+		return fmt.Sprintf("%v", id), nil
+	}
+	return "", nil
+}
+
+// issueSecuritySchemeID returns the ID of the issue security scheme assigned to the project,
+// or an empty string when the project has none.
+func (r *ProjectResource) issueSecuritySchemeID(projectKey string) (string, error) {
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/project/%s/issuesecuritylevelscheme", projectKey), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if id, ok := result["id"]; ok && id != nil {
+		return fmt.Sprintf("%v", id), nil
+	}
+	return "", nil
+}
+
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan ProjectResourceModel
+	var state ProjectResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -243,6 +345,25 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if !plan.AssigneeType.IsNull() && !plan.AssigneeType.IsUnknown() {
 		body["assigneeType"] = plan.AssigneeType.ValueString()
 	}
+	// Notification schemes have no dedicated assignment endpoint; PUT project accepts them directly.
+	if !plan.NotificationSchemeID.IsNull() && !plan.NotificationSchemeID.IsUnknown() {
+		id, err := strconv.ParseInt(plan.NotificationSchemeID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("notification_scheme_id"), "Invalid notification scheme ID",
+				"Scheme ID must be a numeric string (e.g. from jira_notification_scheme.id).")
+			return
+		}
+		body["notificationScheme"] = id
+	}
+	if !plan.CategoryID.IsNull() && !plan.CategoryID.IsUnknown() {
+		id, err := strconv.ParseInt(plan.CategoryID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("category_id"), "Invalid project category ID",
+				"Category ID must be a numeric string (e.g. from jira_project_category.id).")
+			return
+		}
+		body["categoryId"] = id
+	}
 	// Jira Cloud PUT project does not accept issueTypeScheme, permissionScheme, or workflowScheme.
 	var result map[string]interface{}
 	err := r.client.Put(fmt.Sprintf("/rest/api/3/project/%s", plan.Key.ValueString()), body, &result)
@@ -290,6 +411,27 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
+	// Assign issue security scheme only when it changed; the association runs as an async task.
+	if !plan.IssueSecuritySchemeID.IsNull() && !plan.IssueSecuritySchemeID.IsUnknown() &&
+		!plan.IssueSecuritySchemeID.Equal(state.IssueSecuritySchemeID) {
+		securityBody := map[string]interface{}{
+			"projectId":                     plan.ID.ValueString(),
+			"schemeId":                      plan.IssueSecuritySchemeID.ValueString(),
+			"oldToNewSecurityLevelMappings": []interface{}{},
+		}
+		var task map[string]interface{}
+		if err := r.client.Put("/rest/api/3/issuesecurityschemes/project", securityBody, &task); err != nil {
+			resp.Diagnostics.AddError("Error assigning issue security scheme to project", err.Error())
+			return
+		}
+		if taskID := client.TaskIDFromResponse(task); taskID != "" {
+			if err := r.client.WaitForTask(taskID); err != nil {
+				resp.Diagnostics.AddError("Error assigning issue security scheme to project", err.Error())
+				return
+			}
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -342,6 +484,20 @@ func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportSt
 	if id := schemeIDFromResponse(result, "workflowScheme"); id != "" {
 		state.WorkflowSchemeID = types.StringValue(id)
 	}
+	// category_id is only tracked when it is managed, so it is left null here and the first
+	// plan sets the configured category, if any.
+	if id, err := r.notificationSchemeID(state.Key.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error reading project notification scheme", err.Error())
+		return
+	} else if id != "" {
+		state.NotificationSchemeID = types.StringValue(id)
+	}
+	if id, err := r.issueSecuritySchemeID(state.Key.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error reading project issue security scheme", err.Error())
+		return
+	} else if id != "" {
+		state.IssueSecuritySchemeID = types.StringValue(id)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -0,0 +1,171 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ProjectCategoryResource{}
+var _ resource.ResourceWithImportState = &ProjectCategoryResource{}
+
+type ProjectCategoryResource struct {
+	client *client.Client
+}
+
+type ProjectCategoryResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func NewProjectCategoryResource() resource.Resource {
+	return &ProjectCategoryResource{}
+}
+
+func (r *ProjectCategoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_category"
+}
+
+func (r *ProjectCategoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA project category.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The project category ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The project category name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The project category description.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ProjectCategoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *ProjectCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueString()
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post("/rest/api/3/projectCategory", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating project category", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ProjectCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectCategoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/projectCategory/%s", state.ID.ValueString()), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project category", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	state.Description = refreshedString(state.Description, result["description"])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ProjectCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	// A description removed from config is sent as "", so it is cleared.
+	body["description"] = plan.Description.ValueString()
+
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/projectCategory/%s", plan.ID.ValueString()), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating project category", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ProjectCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectCategoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(fmt.Sprintf("/rest/api/3/projectCategory/%s", state.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting project category", err.Error())
+		return
+	}
+}
+
+func (r *ProjectCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/projectCategory/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing project category", err.Error())
+		return
+	}
+
+	state := ProjectCategoryResourceModel{
+		ID:   types.StringValue(fmt.Sprintf("%v", result["id"])),
+		Name: types.StringValue(fmt.Sprintf("%v", result["name"])),
+	}
+	state.Description = refreshedString(state.Description, result["description"])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,262 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ProjectVersionResource{}
+var _ resource.ResourceWithImportState = &ProjectVersionResource{}
+
+type ProjectVersionResource struct {
+	client *client.Client
+}
+
+type ProjectVersionResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ProjectKey           types.String `tfsdk:"project_key"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	StartDate            types.String `tfsdk:"start_date"`
+	ReleaseDate          types.String `tfsdk:"release_date"`
+	Released             types.Bool   `tfsdk:"released"`
+	Archived             types.Bool   `tfsdk:"archived"`
+	MoveFixIssuesTo      types.String `tfsdk:"move_fix_issues_to"`
+	MoveAffectedIssuesTo types.String `tfsdk:"move_affected_issues_to"`
+}
+
+func NewProjectVersionResource() resource.Resource {
+	return &ProjectVersionResource{}
+}
+
+func (r *ProjectVersionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_version"
+}
+
+func (r *ProjectVersionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA project version (release).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The version ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Description: "The project key this version belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The version name. Must be unique within the project.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The version description.",
+				Optional:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "The start date of the version in ISO 8601 format (yyyy-mm-dd).",
+				Optional:    true,
+			},
+			"release_date": schema.StringAttribute{
+				Description: "The release date of the version in ISO 8601 format (yyyy-mm-dd).",
+				Optional:    true,
+			},
+			"released": schema.BoolAttribute{
+				Description: "Whether the version is released. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"archived": schema.BoolAttribute{
+				Description: "Whether the version is archived. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"move_fix_issues_to": schema.StringAttribute{
+				Description: "ID of the version that issues with this version as fixVersion are moved to when it is destroyed. When unset, the version is removed from those issues.",
+				Optional:    true,
+			},
+			"move_affected_issues_to": schema.StringAttribute{
+				Description: "ID of the version that issues with this version as affectedVersion are moved to when it is destroyed. When unset, the version is removed from those issues.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ProjectVersionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *ProjectVersionResource) buildBody(plan ProjectVersionResourceModel) map[string]interface{} {
+	body := map[string]interface{}{
+		"name":     plan.Name.ValueString(),
+		"released": plan.Released.ValueBool(),
+		"archived": plan.Archived.ValueBool(),
+	}
+	// Attributes removed from config are sent as empty values, so they are cleared.
+	body["description"] = plan.Description.ValueString()
+	body["startDate"] = nil
+	if !plan.StartDate.IsNull() {
+		body["startDate"] = plan.StartDate.ValueString()
+	}
+	body["releaseDate"] = nil
+	if !plan.ReleaseDate.IsNull() {
+		body["releaseDate"] = plan.ReleaseDate.ValueString()
+	}
+	return body
+}
+
+func (r *ProjectVersionResource) applyResult(state *ProjectVersionResourceModel, result map[string]interface{}) {
+	state.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	state.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	state.Description = refreshedString(state.Description, result["description"])
+	state.StartDate = refreshedString(state.StartDate, result["startDate"])
+	state.ReleaseDate = refreshedString(state.ReleaseDate, result["releaseDate"])
+	released, _ := result["released"].(bool)
+	state.Released = types.BoolValue(released)
+	archived, _ := result["archived"].(bool)
+	state.Archived = types.BoolValue(archived)
+}
+
+func (r *ProjectVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := r.buildBody(plan)
+	body["project"] = plan.ProjectKey.ValueString()
+
+	var result map[string]interface{}
+	err := r.client.Post("/rest/api/3/version", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating project version", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ProjectVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/version/%s", state.ID.ValueString()), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading project version", err.Error())
+		return
+	}
+
+	r.applyResult(&state, result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ProjectVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/version/%s", plan.ID.ValueString()), r.buildBody(plan), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating project version", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ProjectVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// removeAndSwap moves or clears fixVersion and affectedVersion references before deleting.
+	body := map[string]interface{}{}
+	if !state.MoveFixIssuesTo.IsNull() && state.MoveFixIssuesTo.ValueString() != "" {
+		body["moveFixIssuesTo"] = state.MoveFixIssuesTo.ValueString()
+	}
+	if !state.MoveAffectedIssuesTo.IsNull() && state.MoveAffectedIssuesTo.ValueString() != "" {
+		body["moveAffectedIssuesTo"] = state.MoveAffectedIssuesTo.ValueString()
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post(fmt.Sprintf("/rest/api/3/version/%s/removeAndSwap", state.ID.ValueString()), body, &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting project version", err.Error())
+		return
+	}
+	if taskID := client.TaskIDFromResponse(result); taskID != "" {
+		if err := r.client.WaitForTask(taskID); err != nil {
+			resp.Diagnostics.AddError("Error deleting project version", err.Error())
+			return
+		}
+	}
+}
+
+func (r *ProjectVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/version/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing project version", err.Error())
+		return
+	}
+
+	var state ProjectVersionResourceModel
+	r.applyResult(&state, result)
+
+	// The version only carries the numeric project ID; resolve the key from it.
+	projectID := fmt.Sprintf("%v", result["projectId"])
+	if id, ok := result["projectId"].(float64); ok {
+		projectID = fmt.Sprintf("%.0f", id)
+	}
+	var project map[string]interface{}
+	err = r.client.Get(fmt.Sprintf("/rest/api/3/project/%s", projectID), &project)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading version project", err.Error())
+		return
+	}
+	state.ProjectKey = types.StringValue(fmt.Sprintf("%v", project["key"]))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
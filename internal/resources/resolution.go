@@ -0,0 +1,211 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ResolutionResource{}
+var _ resource.ResourceWithImportState = &ResolutionResource{}
+
+type ResolutionResource struct {
+	client *client.Client
+}
+
+type ResolutionResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	ReplacementID types.String `tfsdk:"replacement_id"`
+}
+
+func NewResolutionResource() resource.Resource {
+	return &ResolutionResource{}
+}
+
+func (r *ResolutionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resolution"
+}
+
+func (r *ResolutionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA issue resolution.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The resolution ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The resolution name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The resolution description.",
+				Optional:    true,
+			},
+			"replacement_id": schema.StringAttribute{
+				Description: "ID of the resolution that issues are moved to when this resolution is destroyed. Defaults to the default resolution.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ResolutionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *ResolutionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResolutionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueString()
+	}
+
+	var result map[string]interface{}
+	err := r.client.Post("/rest/api/3/resolution", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resolution", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%v", result["id"]))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ResolutionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResolutionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/resolution/%s", state.ID.ValueString()), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading resolution", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	if desc, ok := result["description"].(string); ok && desc != "" {
+		state.Description = types.StringValue(desc)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ResolutionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ResolutionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueString()
+	}
+
+	err := r.client.Put(fmt.Sprintf("/rest/api/3/resolution/%s", plan.ID.ValueString()), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating resolution", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ResolutionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResolutionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// JIRA requires a replacement resolution for issues that use the deleted one.
+	replacement := state.ReplacementID.ValueString()
+	if replacement == "" {
+		resolutions, err := r.client.GetAllPages("/rest/api/3/resolution/search", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing resolutions", err.Error())
+			return
+		}
+		for _, res := range resolutions {
+			if isDefault, ok := res["isDefault"].(bool); ok && isDefault {
+				replacement = fmt.Sprintf("%v", res["id"])
+				break
+			}
+		}
+		if replacement == "" || replacement == state.ID.ValueString() {
+			resp.Diagnostics.AddError("Missing replacement resolution",
+				"Set replacement_id to the resolution that issues should be moved to before destroying this resolution.")
+			return
+		}
+	}
+
+	params := url.Values{"replaceWith": {replacement}}
+	var result map[string]interface{}
+	err := r.client.DeleteWithResult(fmt.Sprintf("/rest/api/3/resolution/%s?%s", state.ID.ValueString(), params.Encode()), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting resolution", err.Error())
+		return
+	}
+	if taskID := client.TaskIDFromResponse(result); taskID != "" {
+		if err := r.client.WaitForTask(taskID); err != nil {
+			resp.Diagnostics.AddError("Error deleting resolution", err.Error())
+			return
+		}
+	}
+}
+
+func (r *ResolutionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/api/3/resolution/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing resolution", err.Error())
+		return
+	}
+
+	state := ResolutionResourceModel{
+		ID:   types.StringValue(fmt.Sprintf("%v", result["id"])),
+		Name: types.StringValue(fmt.Sprintf("%v", result["name"])),
+	}
+	if desc, ok := result["description"].(string); ok && desc != "" {
+		state.Description = types.StringValue(desc)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,256 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/david/terraform-provider-jira/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &WebhookResource{}
+var _ resource.ResourceWithImportState = &WebhookResource{}
+
+// webhookJQLFilterName is the admin webhook filter key that holds the JQL.
+const webhookJQLFilterName = "issue-related-events-section"
+
+type WebhookResource struct {
+	client *client.Client
+}
+
+type WebhookResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	URL         types.String `tfsdk:"url"`
+	Events      types.Set    `tfsdk:"events"`
+	JQLFilter   types.String `tfsdk:"jql_filter"`
+	ExcludeBody types.Bool   `tfsdk:"exclude_body"`
+	Secret      types.String `tfsdk:"secret"`
+}
+
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+func (r *WebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *WebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JIRA Cloud admin webhook, as listed under System > WebHooks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The webhook ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The webhook name.",
+				Required:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL that receives the webhook callbacks.",
+				Required:    true,
+			},
+			"events": schema.SetAttribute{
+				Description: "The events that trigger the webhook (e.g. jira:issue_created).",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"jql_filter": schema.StringAttribute{
+				Description: "JQL that limits the issues the webhook fires for.",
+				Optional:    true,
+			},
+			"exclude_body": schema.BoolAttribute{
+				Description: "Whether to send the callback without a request body. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"secret": schema.StringAttribute{
+				Description: "Secret used to sign callbacks with an X-Hub-Signature header. JIRA does not return it, so changes made outside Terraform are not detected.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *WebhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client.")
+		return
+	}
+	r.client = c
+}
+
+func (r *WebhookResource) buildBody(ctx context.Context, plan WebhookResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var events []string
+	diags.Append(plan.Events.ElementsAs(ctx, &events, false)...)
+
+	body := map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"url":         plan.URL.ValueString(),
+		"events":      events,
+		"excludeBody": plan.ExcludeBody.ValueBool(),
+	}
+	if !plan.JQLFilter.IsNull() && !plan.JQLFilter.IsUnknown() {
+		body["filters"] = map[string]interface{}{
+			webhookJQLFilterName: plan.JQLFilter.ValueString(),
+		}
+	}
+	if !plan.Secret.IsNull() && !plan.Secret.IsUnknown() {
+		body["secret"] = plan.Secret.ValueString()
+	}
+	return body, diags
+}
+
+// adminWebhookID extracts the webhook ID from the self link of an admin webhook.
+func adminWebhookID(result map[string]interface{}) string {
+	if self, ok := result["self"].(string); ok && self != "" {
+		return path.Base(self)
+	}
+	if id, ok := result["id"]; ok && id != nil {
+		return fmt.Sprintf("%v", id)
+	}
+	return ""
+}
+
+func (r *WebhookResource) applyResult(ctx context.Context, state *WebhookResourceModel, result map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(adminWebhookID(result))
+	state.Name = types.StringValue(fmt.Sprintf("%v", result["name"]))
+	state.URL = types.StringValue(fmt.Sprintf("%v", result["url"]))
+	var events []string
+	if raw, ok := result["events"].([]interface{}); ok {
+		for _, e := range raw {
+			events = append(events, fmt.Sprintf("%v", e))
+		}
+	}
+	eventSet, d := types.SetValueFrom(ctx, types.StringType, events)
+	diags.Append(d...)
+	state.Events = eventSet
+	jql := ""
+	if filters, ok := result["filters"].(map[string]interface{}); ok {
+		jql, _ = filters[webhookJQLFilterName].(string)
+	}
+	state.JQLFilter = optionalString(jql)
+	excludeBody, _ := result["excludeBody"].(bool)
+	state.ExcludeBody = types.BoolValue(excludeBody)
+
+	return diags
+}
+
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.buildBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var result map[string]interface{}
+	err := r.client.Post("/rest/webhooks/1.0/webhook", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating webhook", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(adminWebhookID(result))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/webhooks/1.0/webhook/%s", state.ID.ValueString()), &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyResult(ctx, &state, result)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.buildBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.Put(fmt.Sprintf("/rest/webhooks/1.0/webhook/%s", plan.ID.ValueString()), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(fmt.Sprintf("/rest/webhooks/1.0/webhook/%s", state.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting webhook", err.Error())
+		return
+	}
+}
+
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by webhook ID.
+	var result map[string]interface{}
+	err := r.client.Get(fmt.Sprintf("/rest/webhooks/1.0/webhook/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing webhook", err.Error())
+		return
+	}
+
+	state := WebhookResourceModel{
+		Secret:    types.StringNull(),
+		JQLFilter: types.StringNull(),
+	}
+	resp.Diagnostics.Append(r.applyResult(ctx, &state, result)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}